@@ -0,0 +1,146 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	headerStyle = lipgloss.NewStyle().Bold(true)
+	cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	emptyStyle  = lipgloss.NewStyle().Faint(true)
+)
+
+type section struct {
+	name  string
+	items []string
+	open  bool
+}
+
+// row is a visible, navigable line: a section header if item is -1,
+// otherwise an item within the section.
+type row struct {
+	section, item int
+}
+
+type model struct {
+	sections []section
+	// multiOpen permits more than one section to be open at a time.
+	multiOpen bool
+	cursor    int
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+// rows returns the rows that can be navigated to. The items of collapsed
+// sections are skipped, as are empty sections' placeholders.
+func (m model) rows() []row {
+	var rows []row
+	for i, s := range m.sections {
+		rows = append(rows, row{section: i, item: -1})
+		if !s.open {
+			continue
+		}
+		for j := range s.items {
+			rows = append(rows, row{section: i, item: j})
+		}
+	}
+	return rows
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		rows := m.rows()
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = min(len(rows)-1, m.cursor+1)
+		case "enter", " ":
+			m.toggle(rows[m.cursor].section)
+		}
+	}
+	return m, nil
+}
+
+// toggle opens or closes a section, keeping the cursor on its header.
+func (m *model) toggle(idx int) {
+	open := !m.sections[idx].open
+	if open && !m.multiOpen {
+		for i := range m.sections {
+			m.sections[i].open = false
+		}
+	}
+	m.sections[idx].open = open
+	for i, r := range m.rows() {
+		if r.section == idx && r.item == -1 {
+			m.cursor = i
+		}
+	}
+}
+
+func (m model) View() string {
+	rows := m.rows()
+	current := rows[m.cursor]
+
+	var b strings.Builder
+	for i, s := range m.sections {
+		arrow := "▸"
+		if s.open {
+			arrow = "▾"
+		}
+		line := fmt.Sprintf("%s %s (%d)", arrow, s.name, len(s.items))
+		if current == (row{section: i, item: -1}) {
+			b.WriteString(cursorStyle.Render("> " + line))
+		} else {
+			b.WriteString("  " + headerStyle.Render(line))
+		}
+		b.WriteString("\n")
+		if !s.open {
+			continue
+		}
+		if len(s.items) == 0 {
+			b.WriteString("      " + emptyStyle.Render("(empty)") + "\n")
+		}
+		for j, item := range s.items {
+			if current == (row{section: i, item: j}) {
+				b.WriteString(cursorStyle.Render("    > " + item))
+			} else {
+				b.WriteString("      " + item)
+			}
+			b.WriteString("\n")
+		}
+	}
+	b.WriteString("\nenter: toggle • j/k: move • q: quit\n")
+	return b.String()
+}
+
+func sections() []section {
+	return []section{
+		{name: "Fruit", items: []string{"apple", "banana", "cherry"}},
+		{name: "Vegetables", items: []string{"carrot", "leek"}},
+		{name: "Dairy"},
+		{name: "Bakery", items: []string{"bread", "croissant", "bagel"}},
+	}
+}
+
+func main() {
+	multi := flag.Bool("multi", false, "allow more than one section open at a time")
+	flag.Parse()
+
+	p := tea.NewProgram(model{sections: sections(), multiOpen: *multi})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
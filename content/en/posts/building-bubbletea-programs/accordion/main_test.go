@@ -0,0 +1,81 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestSingleOpen(t *testing.T) {
+	m := model{sections: sections()}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "> ▸ Fruit (3)")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "      banana")
+
+	// Move past the fruit's items to the next section.
+	tm.Type("jjjj")
+	waitForString(t, tm, "> ▸ Vegetables (2)")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "      leek")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.sections[0].open {
+		t.Error("expected Fruit to collapse when Vegetables was expanded")
+	}
+	if strings.Contains(fm.View(), "banana") {
+		t.Error("expected Fruit's items to be hidden")
+	}
+}
+
+func TestCollapsedSkipped(t *testing.T) {
+	m := model{sections: sections()}
+	m.cursor = 1
+	// With everything collapsed the second row is the second header.
+	if r := m.rows()[m.cursor]; r.section != 1 || r.item != -1 {
+		t.Errorf("expected row to be Vegetables header, got %+v", r)
+	}
+}
+
+func TestEmptySection(t *testing.T) {
+	m := model{sections: sections()}
+	m.toggle(2)
+	if !strings.Contains(m.View(), "(empty)") {
+		t.Error("expected empty section placeholder")
+	}
+	// The placeholder isn't navigable: the row after Dairy is Bakery.
+	if r := m.rows()[m.cursor+1]; r.section != 3 {
+		t.Errorf("expected next row to be Bakery, got %+v", r)
+	}
+}
+
+func TestMultiOpen(t *testing.T) {
+	m := model{sections: sections(), multiOpen: true}
+	m.toggle(0)
+	m.toggle(1)
+	if !m.sections[0].open || !m.sections[1].open {
+		t.Error("expected both sections to be open")
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type model struct {
+	altscreen bool
+	quitting  bool
+	toggles   int
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			m.quitting = true
+			if m.altscreen {
+				// Bubbletea restores the screen on exit regardless, but
+				// leaving the alt screen first means the final view is
+				// printed to the normal buffer and remains visible.
+				m.altscreen = false
+				return m, tea.Sequence(tea.ExitAltScreen, tea.Quit)
+			}
+			return m, tea.Quit
+		case " ":
+			m.toggles++
+			m.altscreen = !m.altscreen
+			if m.altscreen {
+				return m, tea.EnterAltScreen
+			}
+			return m, tea.ExitAltScreen
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.quitting {
+		return fmt.Sprintf("Bye! (toggled %d times)\n", m.toggles)
+	}
+	mode := "inline"
+	if m.altscreen {
+		mode = "altscreen"
+	}
+	return fmt.Sprintf("Mode: %s (toggled %d times)\n\nspace: toggle • q: quit\n", mode, m.toggles)
+}
+
+func main() {
+	p := tea.NewProgram(model{})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestToggle(t *testing.T) {
+	m := model{}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "Mode: inline (toggled 0 times)")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+
+	waitForString(t, tm, "Mode: altscreen (toggled 1 times)")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+
+	waitForString(t, tm, "Mode: inline (toggled 2 times)")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestQuitOnAltScreen(t *testing.T) {
+	m := model{}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	tm.Send(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+
+	waitForString(t, tm, "Mode: altscreen")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.altscreen {
+		t.Error("expected alt screen to be exited on quit")
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const burstSize = 10
+
+var throttledStyle = lipgloss.NewStyle().
+	Background(lipgloss.Color("214")).
+	Foreground(lipgloss.Color("0")).
+	Padding(0, 1)
+
+type (
+	refillMsg   struct{}
+	responseMsg struct{ id int }
+)
+
+// limiter is a token bucket: each request consumes a token, and tokens are
+// added back one at a time at a fixed interval, up to the capacity.
+type limiter struct {
+	capacity  int
+	tokens    int
+	interval  time.Duration
+	refilling bool
+}
+
+type model struct {
+	limiter limiter
+	latency time.Duration
+	queue   []int
+	nextID  int
+	sent    []int
+	done    []int
+}
+
+func newModel(capacity int, interval, latency time.Duration) model {
+	return model{
+		limiter: limiter{capacity: capacity, tokens: capacity, interval: interval},
+		latency: latency,
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "r":
+			m.enqueue(1)
+			return m, m.dispatch()
+		case "b":
+			m.enqueue(burstSize)
+			return m, m.dispatch()
+		}
+	case refillMsg:
+		m.limiter.refilling = false
+		m.limiter.tokens = min(m.limiter.capacity, m.limiter.tokens+1)
+		return m, m.dispatch()
+	case responseMsg:
+		m.done = append(m.done, msg.id)
+	}
+	return m, nil
+}
+
+func (m *model) enqueue(n int) {
+	for range n {
+		m.nextID++
+		m.queue = append(m.queue, m.nextID)
+	}
+}
+
+// dispatch sends as many queued requests as there are tokens for, and
+// schedules a refill if the bucket isn't full.
+func (m *model) dispatch() tea.Cmd {
+	var cmds []tea.Cmd
+	for len(m.queue) > 0 && m.limiter.tokens > 0 {
+		id := m.queue[0]
+		m.queue = m.queue[1:]
+		m.limiter.tokens--
+		m.sent = append(m.sent, id)
+		cmds = append(cmds, m.request(id))
+	}
+	if m.limiter.tokens < m.limiter.capacity && !m.limiter.refilling {
+		m.limiter.refilling = true
+		cmds = append(cmds, tea.Tick(m.limiter.interval, func(time.Time) tea.Msg {
+			return refillMsg{}
+		}))
+	}
+	return tea.Batch(cmds...)
+}
+
+func (m model) request(id int) tea.Cmd {
+	return tea.Tick(m.latency, func(time.Time) tea.Msg {
+		return responseMsg{id: id}
+	})
+}
+
+func (m model) throttled() bool {
+	return len(m.queue) > 0
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "tokens: %d/%d • queue: %d • sent: %d • completed: %d",
+		m.limiter.tokens, m.limiter.capacity, len(m.queue), len(m.sent), len(m.done))
+	if m.throttled() {
+		b.WriteString(" " + throttledStyle.Render("THROTTLED"))
+	}
+	b.WriteString("\n\n")
+	last := m.done[max(0, len(m.done)-5):]
+	for _, id := range last {
+		fmt.Fprintf(&b, "request %d completed\n", id)
+	}
+	b.WriteString("\nr: send request • b: send burst • q: quit\n")
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel(3, 500*time.Millisecond, 200*time.Millisecond))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestBurst(t *testing.T) {
+	m := newModel(3, 50*time.Millisecond, time.Millisecond)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	tm.Type("b")
+
+	waitForMatch(t, tm, `tokens: 0/3 • queue: 7 • sent: 3 • completed: \d+\s+THROTTLED`)
+
+	waitForMatch(t, tm, `tokens: 3/3 • queue: 0 • sent: 10 • completed: 10\s*\n`)
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	want := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	if !slices.Equal(fm.sent, want) {
+		t.Errorf("expected requests to be sent in order, got %v", fm.sent)
+	}
+	// The first three requests are sent together, so may complete in any
+	// order, but the rest are each held back until a token is added, so
+	// complete in the order they were sent.
+	if len(fm.done) != len(want) {
+		t.Fatalf("expected all requests to complete, got %v", fm.done)
+	}
+	first := slices.Clone(fm.done[:3])
+	slices.Sort(first)
+	if !slices.Equal(first, want[:3]) || !slices.Equal(fm.done[3:], want[3:]) {
+		t.Errorf("expected requests to complete in the order they were sent, got %v", fm.done)
+	}
+}
+
+func waitForMatch(t *testing.T, tm *teatest.TestModel, pattern string) {
+	re := regexp.MustCompile(pattern)
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return re.Match(b)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+const (
+	// colWidth and rowHeight are the size of each cell of the grid the
+	// boxes are positioned on.
+	colWidth  = 20
+	rowHeight = 6
+	// boxHeight is the height of every box, including its border.
+	boxHeight = 3
+)
+
+var helpStyle = lipgloss.NewStyle().Faint(true)
+
+type node struct {
+	id, label string
+	col, row  int
+}
+
+type edge struct {
+	from, to string
+}
+
+type rect struct {
+	x, y, w, h int
+}
+
+func (r rect) right() int   { return r.x + r.w - 1 }
+func (r rect) bottom() int  { return r.y + r.h - 1 }
+func (r rect) centerX() int { return r.x + r.w/2 }
+func (r rect) middle() int  { return r.y + r.h/2 }
+
+func (r rect) overlaps(o rect) bool {
+	// Boxes must be at least one column apart to be told apart.
+	return r.x <= o.right()+1 && o.x <= r.right()+1 && r.y <= o.bottom() && o.y <= r.bottom()
+}
+
+// layout positions each node's box on the grid. Boxes that would overlap an
+// earlier box, e.g. because both are in the same cell or a label is wider
+// than a cell, are pushed right until they don't.
+func layout(nodes []node) map[string]rect {
+	rects := make(map[string]rect, len(nodes))
+	var placed []rect
+	for _, n := range nodes {
+		r := rect{x: n.col * colWidth, y: n.row * rowHeight, w: runewidth.StringWidth(n.label) + 4, h: boxHeight}
+		for moved := true; moved; {
+			moved = false
+			for _, p := range placed {
+				if r.overlaps(p) {
+					r.x = p.right() + 3
+					moved = true
+				}
+			}
+		}
+		placed = append(placed, r)
+		rects[n.id] = r
+	}
+	return rects
+}
+
+// canvas is a grid of runes that lines and boxes are drawn on.
+type canvas [][]rune
+
+func newCanvas(w, h int) canvas {
+	c := make(canvas, h)
+	for y := range c {
+		c[y] = []rune(strings.Repeat(" ", w))
+	}
+	return c
+}
+
+// set draws a line segment at x, y. Where it crosses a line running the
+// other way the two are joined, and it never overwrites a corner or arrow.
+func (c canvas) set(x, y int, r rune) {
+	if y < 0 || y >= len(c) || x < 0 || x >= len(c[y]) {
+		return
+	}
+	switch existing := c[y][x]; {
+	case existing == '│' && r == '─', existing == '─' && r == '│':
+		r = '┼'
+	case existing != ' ' && existing != r:
+		return
+	}
+	c[y][x] = r
+}
+
+// mark draws a corner or arrow at x, y.
+func (c canvas) mark(x, y int, r rune) {
+	if y >= 0 && y < len(c) && x >= 0 && x < len(c[y]) {
+		c[y][x] = r
+	}
+}
+
+func (c canvas) hline(x1, x2, y int) {
+	for x := min(x1, x2); x <= max(x1, x2); x++ {
+		c.set(x, y, '─')
+	}
+}
+
+func (c canvas) vline(x, y1, y2 int) {
+	for y := min(y1, y2); y <= max(y1, y2); y++ {
+		c.set(x, y, '│')
+	}
+}
+
+// box draws a box, overwriting anything beneath it, including lines.
+func (c canvas) box(r rect, label string) {
+	for y := r.y; y <= r.bottom(); y++ {
+		for x := r.x; x <= r.right(); x++ {
+			c[y][x] = ' '
+		}
+	}
+	c[r.y][r.x], c[r.y][r.right()] = '┌', '┐'
+	c[r.bottom()][r.x], c[r.bottom()][r.right()] = '└', '┘'
+	for x := r.x + 1; x < r.right(); x++ {
+		c[r.y][x], c[r.bottom()][x] = '─', '─'
+	}
+	for y := r.y + 1; y < r.bottom(); y++ {
+		c[y][r.x], c[y][r.right()] = '│', '│'
+	}
+	x := r.x + 2
+	for _, ch := range label {
+		c[r.middle()][x] = ch
+		x += runewidth.RuneWidth(ch)
+	}
+}
+
+// connect routes a connector from a to b: straight across when they're on
+// the same row, straight down or up when b is beneath or above a, and
+// otherwise vertically out of a and then horizontally into the side of b.
+func (c canvas) connect(a, b rect) {
+	switch {
+	case a.middle() == b.middle() && b.x > a.right():
+		c.hline(a.right()+1, b.x-1, a.middle())
+		c.mark(b.x-1, a.middle(), '▶')
+	case a.middle() == b.middle():
+		c.hline(b.right()+1, a.x-1, a.middle())
+		c.mark(b.right()+1, a.middle(), '◀')
+	case a.centerX() >= b.x && a.centerX() <= b.right():
+		if b.y > a.y {
+			c.vline(a.centerX(), a.bottom()+1, b.y-1)
+			c.mark(a.centerX(), b.y-1, '▼')
+		} else {
+			c.vline(a.centerX(), b.bottom()+1, a.y-1)
+			c.mark(a.centerX(), b.bottom()+1, '▲')
+		}
+	default:
+		down := b.y > a.y
+		start, end := a.bottom()+1, b.middle()-1
+		if !down {
+			start, end = a.y-1, b.middle()+1
+		}
+		c.vline(a.centerX(), start, end)
+		corner, x, arrow := '└', b.x-1, '▶'
+		if b.x < a.centerX() {
+			corner, x, arrow = '┘', b.right()+1, '◀'
+		}
+		if !down && corner == '└' {
+			corner = '┌'
+		} else if !down {
+			corner = '┐'
+		}
+		c.hline(a.centerX(), x, b.middle())
+		c.mark(a.centerX(), b.middle(), corner)
+		c.mark(x, b.middle(), arrow)
+	}
+}
+
+func (c canvas) String() string {
+	lines := make([]string, len(c))
+	for y, row := range c {
+		lines[y] = strings.TrimRight(string(row), " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// render draws the diagram. The connectors are drawn first, so that boxes
+// are drawn over any connector that passes beneath them.
+func render(nodes []node, edges []edge) string {
+	rects := layout(nodes)
+	var w, h int
+	for _, r := range rects {
+		w, h = max(w, r.right()+2), max(h, r.bottom()+2)
+	}
+	c := newCanvas(w, h)
+	for _, e := range edges {
+		a, ok := rects[e.from]
+		b, ok2 := rects[e.to]
+		if ok && ok2 {
+			c.connect(a, b)
+		}
+	}
+	for _, n := range nodes {
+		c.box(rects[n.id], n.label)
+	}
+	return c.String()
+}
+
+type model struct {
+	diagram string
+}
+
+func newModel(nodes []node, edges []edge) model {
+	return model{diagram: render(nodes, edges)}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	return m.diagram + "\n\n" + helpStyle.Render("q: quit") + "\n"
+}
+
+func main() {
+	nodes := []node{
+		{id: "req", label: "Request", col: 0, row: 0},
+		{id: "auth", label: "Authenticated?", col: 1, row: 0},
+		{id: "login", label: "Login", col: 0, row: 1},
+		{id: "audit", label: "Audit log", col: 2, row: 1},
+		{id: "handler", label: "Handler", col: 1, row: 2},
+		{id: "db", label: "Database", col: 2, row: 2},
+		{id: "done", label: "Done", col: 3, row: 0},
+	}
+	edges := []edge{
+		{"req", "auth"},
+		{"req", "login"},
+		// Crosses the connector from login to the audit log.
+		{"auth", "handler"},
+		{"login", "audit"},
+		{"handler", "db"},
+		{"audit", "done"},
+	}
+	p := tea.NewProgram(newModel(nodes, edges))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
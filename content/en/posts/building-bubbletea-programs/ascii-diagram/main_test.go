@@ -0,0 +1,74 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestTwoNodes(t *testing.T) {
+	nodes := []node{
+		{id: "start", label: "Start", col: 0, row: 0},
+		{id: "end", label: "End", col: 1, row: 0},
+	}
+	m := newModel(nodes, []edge{{"start", "end"}})
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 20))
+
+	waitForStrings(t, tm,
+		"┌───────┐           ┌─────┐",
+		"│ Start │──────────▶│ End │",
+		"└───────┘           └─────┘",
+	)
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestOverlap(t *testing.T) {
+	// Both nodes are in the same cell, so the second is pushed right.
+	got := render([]node{
+		{id: "a", label: "First", col: 0, row: 0},
+		{id: "b", label: "Second", col: 0, row: 0},
+	}, nil)
+	want := "" +
+		"┌───────┐  ┌────────┐\n" +
+		"│ First │  │ Second │\n" +
+		"└───────┘  └────────┘\n"
+	if got != want {
+		t.Errorf("want:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestCrossing(t *testing.T) {
+	got := render([]node{
+		{id: "a", label: "A", col: 1, row: 0},
+		{id: "b", label: "B", col: 1, row: 2},
+		{id: "c", label: "C", col: 0, row: 1},
+		{id: "d", label: "D", col: 2, row: 1},
+	}, []edge{{"a", "b"}, {"c", "d"}})
+	if !strings.Contains(got, "│ C │─────────────────┼────────────────▶│ D │") {
+		t.Errorf("expected connectors to cross, got:\n%s", got)
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
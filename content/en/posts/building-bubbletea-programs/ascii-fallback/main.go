@@ -0,0 +1,184 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// glyphs are the symbols the UI draws with.
+type glyphs struct {
+	border  lipgloss.Border
+	spinner spinner.Spinner
+	done    string
+	failed  string
+	pending string
+}
+
+var (
+	unicodeGlyphs = glyphs{
+		border:  lipgloss.RoundedBorder(),
+		spinner: spinner.Dot,
+		done:    "✓",
+		failed:  "✗",
+		pending: "•",
+	}
+	asciiGlyphs = glyphs{
+		border: lipgloss.Border{
+			Top: "-", Bottom: "-", Left: "|", Right: "|",
+			TopLeft: "+", TopRight: "+", BottomLeft: "+", BottomRight: "+",
+		},
+		spinner: spinner.Line,
+		done:    "*",
+		failed:  "x",
+		pending: "-",
+	}
+)
+
+// fallbacks are ASCII equivalents for Unicode characters that commonly turn
+// up in text. Anything else that isn't ASCII becomes a question mark.
+var fallbacks = strings.NewReplacer(
+	"→", "->",
+	"←", "<-",
+	"…", "...",
+	"—", "--",
+	"–", "-",
+	"“", `"`,
+	"”", `"`,
+	"‘", "'",
+	"’", "'",
+	"✓", "*",
+	"•", "-",
+)
+
+// toASCII replaces the characters in s that have an ASCII equivalent, and
+// any others that don't with '?'.
+func toASCII(s string) string {
+	s = fallbacks.Replace(s)
+	return strings.Map(func(r rune) rune {
+		if r > 127 {
+			return '?'
+		}
+		return r
+	}, s)
+}
+
+// utf8Locale reports whether the locale uses UTF-8, checking the variables
+// in the order that they take precedence. With none set, the locale is
+// "C", which is ASCII.
+func utf8Locale(getenv func(string) string) bool {
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := getenv(name); v != "" {
+			v = strings.ToLower(v)
+			return strings.Contains(v, "utf-8") || strings.Contains(v, "utf8")
+		}
+	}
+	return false
+}
+
+type status int
+
+const (
+	pending status = iota
+	running
+	done
+	failed
+)
+
+type task struct {
+	name   string
+	status status
+}
+
+type model struct {
+	ascii   bool
+	glyphs  glyphs
+	tasks   []task
+	spinner spinner.Model
+}
+
+func newModel(tasks []task, ascii bool) model {
+	g := unicodeGlyphs
+	if ascii {
+		g = asciiGlyphs
+	}
+	return model{
+		ascii:   ascii,
+		glyphs:  g,
+		tasks:   tasks,
+		spinner: spinner.New(spinner.WithSpinner(g.spinner)),
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.spinner.Tick
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+	}
+	return m, nil
+}
+
+// text returns s as is, or with its non-ASCII characters replaced in ASCII
+// mode. The model's own glyphs don't need replacing; it's only text from
+// elsewhere, such as task names, that can contain anything.
+func (m model) text(s string) string {
+	if m.ascii {
+		return toASCII(s)
+	}
+	return s
+}
+
+func (m model) View() string {
+	var lines []string
+	for _, t := range m.tasks {
+		var icon string
+		switch t.status {
+		case pending:
+			icon = m.glyphs.pending
+		case running:
+			icon = m.spinner.View()
+		case done:
+			icon = m.glyphs.done
+		case failed:
+			icon = m.glyphs.failed
+		}
+		lines = append(lines, icon+" "+m.text(t.name))
+	}
+	box := lipgloss.NewStyle().Border(m.glyphs.border).Padding(0, 1).Width(40)
+	return box.Render(strings.Join(lines, "\n")) + "\n" + m.text("q: quit • ascii mode: ") + fmt.Sprint(m.ascii) + "\n"
+}
+
+func main() {
+	ascii := flag.Bool("ascii", false, "use ASCII rather than Unicode")
+	flag.Parse()
+
+	tasks := []task{
+		{name: "fetch “dependencies”", status: done},
+		{name: "build → dist/", status: done},
+		{name: "run tests…", status: running},
+		{name: "deploy to café-prod", status: pending},
+		{name: "notify #releases", status: failed},
+	}
+	m := newModel(tasks, *ascii || !utf8Locale(os.Getenv))
+	p := tea.NewProgram(m)
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
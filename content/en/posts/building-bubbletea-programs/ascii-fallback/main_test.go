@@ -0,0 +1,111 @@
+package main
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func testTasks() []task {
+	return []task{
+		{name: "fetch “dependencies”", status: done},
+		{name: "build → dist/", status: done},
+		{name: "run tests…", status: running},
+		{name: "deploy to café-prod", status: pending},
+		{name: "notify #releases", status: failed},
+	}
+}
+
+func TestASCII(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(testTasks(), true), teatest.WithInitialTermSize(80, 20))
+
+	// Let the spinner spin a few times.
+	time.Sleep(500 * time.Millisecond)
+
+	tm.Type("q")
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+
+	// Read all of the output, rather than wait for parts of it, so that
+	// all of it is checked.
+	out, err := io.ReadAll(tm.FinalOutput(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range string(out) {
+		if r > 127 {
+			t.Fatalf("unexpected non-ASCII character %q in output:\n%s", r, out)
+		}
+	}
+	// Text with ASCII equivalents is translated, and anything else is
+	// replaced.
+	for _, want := range []string{
+		"+----------------------------------------+",
+		`| * fetch "dependencies"`,
+		"| * build -> dist/",
+		"run tests...",
+		"| - deploy to caf?-prod",
+		"| x notify #releases",
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("expected output to contain %q", want)
+		}
+	}
+}
+
+func TestUnicode(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(testTasks(), false), teatest.WithInitialTermSize(80, 20))
+
+	waitForStrings(t, tm,
+		"╭────────────────────────────────────────╮",
+		"│ ✓ build → dist/",
+		"│ • deploy to café-prod",
+	)
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestUTF8Locale(t *testing.T) {
+	tests := []struct {
+		env  map[string]string
+		want bool
+	}{
+		{env: map[string]string{}, want: false},
+		{env: map[string]string{"LANG": "en_GB.UTF-8"}, want: true},
+		{env: map[string]string{"LANG": "en_US.utf8"}, want: true},
+		{env: map[string]string{"LANG": "C"}, want: false},
+		// LC_ALL overrides LANG, both ways.
+		{env: map[string]string{"LANG": "en_GB.UTF-8", "LC_ALL": "C"}, want: false},
+		{env: map[string]string{"LANG": "C", "LC_ALL": "en_GB.UTF-8"}, want: true},
+		{env: map[string]string{"LANG": "C", "LC_CTYPE": "en_GB.UTF-8"}, want: true},
+	}
+	for _, tt := range tests {
+		getenv := func(name string) string { return tt.env[name] }
+		if got := utf8Locale(getenv); got != tt.want {
+			t.Errorf("%v: want %v, got %v", tt.env, tt.want, got)
+		}
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
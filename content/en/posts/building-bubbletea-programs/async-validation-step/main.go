@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	titleStyle    = lipgloss.NewStyle().Bold(true).MarginBottom(1)
+	errorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	successStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+	buttonStyle   = lipgloss.NewStyle().Background(lipgloss.Color("62")).Foreground(lipgloss.Color("230")).Padding(0, 1)
+	disabledStyle = lipgloss.NewStyle().Background(lipgloss.Color("237")).Foreground(lipgloss.Color("243")).Padding(0, 1)
+)
+
+// errTaken is returned by a validator when the username is already in use.
+var errTaken = errors.New("username is taken")
+
+// validator checks a username with the remote service. It should give up
+// when the context is cancelled.
+type validator func(ctx context.Context, username string) error
+
+type validatedMsg struct {
+	id  int
+	err error
+}
+
+type status int
+
+const (
+	empty status = iota
+	checking
+	available
+	taken
+	failed
+)
+
+const (
+	usernameStep = iota
+	doneStep
+)
+
+type model struct {
+	validate validator
+	step     int
+	input    textinput.Model
+	spinner  spinner.Model
+	spinning bool
+
+	status status
+	err    error
+	// checkID identifies the latest check, so that the results of any
+	// earlier check still in flight are ignored.
+	checkID int
+	cancel  context.CancelFunc
+}
+
+func newModel(validate validator) model {
+	input := textinput.New()
+	input.Prompt = "username: "
+	input.CharLimit = 20
+	input.Focus()
+	return model{validate: validate, input: input, spinner: spinner.New(spinner.WithSpinner(spinner.Dot))}
+}
+
+func (m model) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "esc":
+			m.stop()
+			return m, tea.Quit
+		case "enter":
+			if m.step == usernameStep && m.status == available {
+				m.step = doneStep
+				m.input.Blur()
+			}
+			return m, nil
+		case "ctrl+r":
+			if m.status == failed {
+				return m, m.check()
+			}
+			return m, nil
+		}
+		if m.step != usernameStep {
+			return m, nil
+		}
+		before := m.input.Value()
+		var cmd tea.Cmd
+		m.input, cmd = m.input.Update(msg)
+		if m.input.Value() == before {
+			return m, cmd
+		}
+		return m, tea.Batch(cmd, m.check())
+	case validatedMsg:
+		if msg.id != m.checkID {
+			// The username has been edited since this check started.
+			return m, nil
+		}
+		m.cancel = nil
+		switch {
+		case msg.err == nil:
+			m.status = available
+		case errors.Is(msg.err, errTaken):
+			m.status = taken
+		default:
+			m.status, m.err = failed, msg.err
+		}
+		return m, nil
+	case spinner.TickMsg:
+		if m.status != checking {
+			m.spinning = false
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+	}
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+// check cancels any check in flight and starts a new one for the current
+// username.
+func (m *model) check() tea.Cmd {
+	m.stop()
+	m.checkID++
+	username := strings.TrimSpace(m.input.Value())
+	if username == "" {
+		m.status = empty
+		return nil
+	}
+	m.status, m.err = checking, nil
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	id, validate := m.checkID, m.validate
+	cmds := []tea.Cmd{func() tea.Msg {
+		return validatedMsg{id: id, err: validate(ctx, username)}
+	}}
+	if !m.spinning {
+		m.spinning = true
+		cmds = append(cmds, m.spinner.Tick)
+	}
+	return tea.Batch(cmds...)
+}
+
+func (m *model) stop() {
+	if m.cancel != nil {
+		m.cancel()
+		m.cancel = nil
+	}
+}
+
+func (m model) View() string {
+	if m.step == doneStep {
+		return successStyle.Render(fmt.Sprintf("Welcome, %s!", strings.TrimSpace(m.input.Value()))) + "\n"
+	}
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Step 1 of 2: choose a username") + "\n")
+	b.WriteString(m.input.View() + "\n\n")
+	switch m.status {
+	case empty:
+		b.WriteString("enter a username")
+	case checking:
+		b.WriteString(m.spinner.View() + " checking availability…")
+	case available:
+		b.WriteString(successStyle.Render("✓ available"))
+	case taken:
+		b.WriteString(errorStyle.Render("✗ " + errTaken.Error()))
+	case failed:
+		b.WriteString(errorStyle.Render("could not check username: "+m.err.Error()) + " • ctrl+r: retry")
+	}
+	b.WriteString("\n\n")
+	if m.status == available {
+		b.WriteString(buttonStyle.Render("Next"))
+	} else {
+		b.WriteString(disabledStyle.Render("Next (disabled)"))
+	}
+	b.WriteString("\n\nenter: next • esc: quit\n")
+	return b.String()
+}
+
+// lookup pretends to ask a remote service whether a username is free.
+func lookup(ctx context.Context, username string) error {
+	select {
+	case <-time.After(time.Duration(300+rand.Intn(700)) * time.Millisecond):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	switch {
+	case rand.Intn(10) == 0:
+		return errors.New("service unavailable")
+	case username == "admin" || username == "root":
+		return errTaken
+	}
+	return nil
+}
+
+func main() {
+	p := tea.NewProgram(newModel(lookup))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+// fakeValidator blocks each check until the test releases a result for
+// that username, and records which checks were cancelled.
+type fakeValidator struct {
+	mu        sync.Mutex
+	results   map[string]chan error
+	cancelled []string
+}
+
+func (f *fakeValidator) channel(username string) chan error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.results == nil {
+		f.results = make(map[string]chan error)
+	}
+	if _, ok := f.results[username]; !ok {
+		f.results[username] = make(chan error, 1)
+	}
+	return f.results[username]
+}
+
+func (f *fakeValidator) release(username string, err error) {
+	f.channel(username) <- err
+}
+
+func (f *fakeValidator) validate(ctx context.Context, username string) error {
+	select {
+	case err := <-f.channel(username):
+		return err
+	case <-ctx.Done():
+		f.mu.Lock()
+		f.cancelled = append(f.cancelled, username)
+		f.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+func TestNextDisabledUntilValid(t *testing.T) {
+	var fake fakeValidator
+	m := newModel(fake.validate)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	// Typing the second letter cancels the check of the first.
+	tm.Type("bo")
+
+	waitForStrings(t, tm, "checking availability…", "Next (disabled)")
+
+	// Next does nothing while the check is in flight.
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	fake.release("bo", errTaken)
+
+	waitForStrings(t, tm, "✗ username is taken")
+
+	tm.Type("b")
+
+	waitForStrings(t, tm, "checking availability…")
+
+	fake.release("bob", errors.New("connection refused"))
+
+	waitForStrings(t, tm, "could not check username: connection refused • ctrl+r: retry")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlR})
+
+	waitForStrings(t, tm, "checking availability…")
+
+	fake.release("bob", nil)
+
+	waitForStrings(t, tm, "✓ available")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForStrings(t, tm, "Welcome, bob!")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.step != doneStep {
+		t.Errorf("expected to reach the final step, got step %d", fm.step)
+	}
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if !slices.Contains(fake.cancelled, "b") {
+		t.Errorf("expected the check of %q to be cancelled, got %v", "b", fake.cancelled)
+	}
+}
+
+func TestStaleResultIgnored(t *testing.T) {
+	m := newModel(nil)
+	m.input.SetValue("bob")
+	m.status, m.checkID = checking, 2
+
+	// A result for an earlier username arrives after it was edited.
+	updated, _ := m.Update(validatedMsg{id: 1, err: nil})
+	if got := updated.(model).status; got != checking {
+		t.Errorf("expected stale result to be ignored, got status %d", got)
+	}
+
+	updated, _ = m.Update(validatedMsg{id: 2, err: errTaken})
+	if got := updated.(model).status; got != taken {
+		t.Errorf("expected latest result to be used, got status %d", got)
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var pausedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+
+type entryMsg string
+
+type model struct {
+	interval time.Duration
+	entries  []string
+	viewport viewport.Model
+	ready    bool
+	// following is true while the viewport should stick to the newest
+	// entry.
+	following bool
+}
+
+func newModel(interval time.Duration) model {
+	return model{interval: interval, following: true}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.next()
+}
+
+// next emits a new log entry after the interval.
+func (m model) next() tea.Cmd {
+	n := len(m.entries) + 1
+	return tea.Tick(m.interval, func(t time.Time) tea.Msg {
+		return entryMsg(fmt.Sprintf("%s entry %03d", t.Format(time.TimeOnly), n))
+	})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "end":
+			m.viewport.GotoBottom()
+			m.following = true
+			return m, nil
+		case "home":
+			m.viewport.GotoTop()
+			m.following = m.viewport.AtBottom()
+			return m, nil
+		}
+	case tea.WindowSizeMsg:
+		if !m.ready {
+			m.viewport = viewport.New(msg.Width, msg.Height-1)
+			m.ready = true
+		} else {
+			m.viewport.Width = msg.Width
+			m.viewport.Height = msg.Height - 1
+		}
+		m.viewport.SetContent(strings.Join(m.entries, "\n"))
+		if m.following {
+			m.viewport.GotoBottom()
+		} else {
+			// Stay paused, but keep the offset within the new bounds:
+			// the viewport doesn't clamp it when its height changes.
+			m.viewport.SetYOffset(m.viewport.YOffset)
+		}
+		return m, nil
+	case entryMsg:
+		m.entries = append(m.entries, string(msg))
+		m.viewport.SetContent(strings.Join(m.entries, "\n"))
+		if m.following {
+			m.viewport.GotoBottom()
+		}
+		return m, m.next()
+	}
+
+	// Any scrolling by the user pauses following, until they scroll back
+	// to the bottom.
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	switch msg.(type) {
+	case tea.KeyMsg, tea.MouseMsg:
+		m.following = m.viewport.AtBottom()
+	}
+	return m, cmd
+}
+
+func (m model) View() string {
+	if !m.ready {
+		return "loading..."
+	}
+	status := "following • q: quit"
+	if !m.following {
+		status = pausedStyle.Render("paused — press End to resume")
+	}
+	return m.viewport.View() + "\n" + status
+}
+
+func main() {
+	p := tea.NewProgram(newModel(200*time.Millisecond), tea.WithAltScreen(), tea.WithMouseCellMotion())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestAutoFollow(t *testing.T) {
+	m := newModel(10 * time.Millisecond)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 10))
+
+	waitForString(t, tm, "entry 020")
+
+	// Scrolling up pauses following.
+	tm.Send(tea.KeyMsg{Type: tea.KeyUp})
+
+	waitForString(t, tm, "paused — press End to resume")
+
+	// Resizing while paused keeps it paused.
+	tm.Send(tea.WindowSizeMsg{Width: 80, Height: 6})
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnd})
+
+	waitForString(t, tm, "following")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	fm := tm.FinalModel(t).(model)
+	if !fm.following {
+		t.Error("expected to be following")
+	}
+	if !fm.viewport.AtBottom() {
+		t.Error("expected viewport to be at the bottom")
+	}
+}
+
+func TestResizeWhilePaused(t *testing.T) {
+	m := newModel(time.Hour)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 10))
+
+	for i := 0; i < 30; i++ {
+		tm.Send(entryMsg("entry"))
+	}
+	tm.Send(tea.KeyMsg{Type: tea.KeyPgUp})
+
+	waitForString(t, tm, "paused")
+
+	tm.Send(tea.WindowSizeMsg{Width: 80, Height: 40})
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	fm := tm.FinalModel(t).(model)
+	if fm.following {
+		t.Error("expected to still be paused after resize")
+	}
+	// The taller viewport now fits every entry, so the offset must have
+	// been clamped to the top.
+	if fm.viewport.YOffset != 0 {
+		t.Errorf("expected offset to be clamped to 0, got %d", fm.viewport.YOffset)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+)
+
+const maxSuggestions = 5
+
+var dictionary = []string{
+	"apple", "apricot", "avocado", "banana", "blackberry", "blueberry",
+	"cantaloupe", "cherry", "coconut", "cranberry", "date", "dragonfruit",
+	"fig", "grape", "grapefruit", "guava", "kiwi", "lemon", "lime", "lychee",
+	"mango", "melon", "nectarine", "orange", "papaya", "peach", "pear",
+	"pineapple", "plum", "pomegranate", "raspberry", "strawberry", "tangerine",
+	"watermelon",
+}
+
+var (
+	suggestionStyle = lipgloss.NewStyle().PaddingLeft(2)
+	selectedStyle   = lipgloss.NewStyle().PaddingLeft(2).Bold(true).Foreground(lipgloss.Color("205"))
+)
+
+type model struct {
+	input   textinput.Model
+	words   []string
+	matches []string
+	// selected is the index of the highlighted suggestion, or -1 if none is
+	// highlighted.
+	selected int
+}
+
+func newModel(words []string) model {
+	input := textinput.New()
+	input.Placeholder = "type a fruit"
+	input.Focus()
+	return model{input: input, words: words, selected: -1}
+}
+
+func (m model) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "esc":
+			return m, tea.Quit
+		case "down":
+			if len(m.matches) > 0 {
+				m.selected = (m.selected + 1) % len(m.matches)
+			}
+			return m, nil
+		case "up":
+			if len(m.matches) > 0 {
+				m.selected = (m.selected - 1 + len(m.matches)) % len(m.matches)
+			}
+			return m, nil
+		case "tab":
+			if m.selected < 0 {
+				return m, nil
+			}
+			m.input.SetValue(m.matches[m.selected])
+			m.input.CursorEnd()
+			m.matches = nil
+			m.selected = -1
+			return m, nil
+		}
+	}
+
+	before := m.input.Value()
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	if m.input.Value() != before {
+		m.matches = m.suggest(m.input.Value())
+		m.selected = -1
+	}
+	return m, cmd
+}
+
+func (m model) suggest(query string) []string {
+	if query == "" {
+		return nil
+	}
+	results := fuzzy.Find(query, m.words)
+	matches := make([]string, 0, maxSuggestions)
+	for i := 0; i < len(results) && i < maxSuggestions; i++ {
+		matches = append(matches, results[i].Str)
+	}
+	return matches
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	b.WriteString(m.input.View())
+	b.WriteString("\n")
+	for i, match := range m.matches {
+		if i == m.selected {
+			b.WriteString(selectedStyle.Render("> " + match))
+		} else {
+			b.WriteString(suggestionStyle.Render("  " + match))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n↑/↓: select • tab: accept • esc: quit\n")
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel(dictionary))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
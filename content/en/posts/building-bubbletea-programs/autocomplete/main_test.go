@@ -0,0 +1,64 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestAccept(t *testing.T) {
+	m := newModel(dictionary)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	tm.Type("watr")
+
+	waitForString(t, tm, "watermelon")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+
+	waitForString(t, tm, "> watermelon")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyTab})
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.input.Value(); got != "watermelon" {
+		t.Errorf("expected input to be %q, got %q", "watermelon", got)
+	}
+	if len(fm.matches) != 0 {
+		t.Errorf("expected dropdown to be hidden, got %v", fm.matches)
+	}
+}
+
+func TestNoMatches(t *testing.T) {
+	m := newModel(dictionary)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	tm.Type("xq")
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeyTab})
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.input.Value(); got != "xq" {
+		t.Errorf("expected tab to be a no-op, got input %q", got)
+	}
+	if len(fm.matches) != 0 {
+		t.Errorf("expected no matches, got %v", fm.matches)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,100 @@
+// Package banner provides a dismissable banner for showing messages at the
+// top of the screen.
+package banner
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Level is the severity of a banner.
+type Level int
+
+const (
+	Info Level = iota
+	Warning
+	Error
+)
+
+var levelStyles = map[Level]lipgloss.Style{
+	Info:    lipgloss.NewStyle().Background(lipgloss.Color("25")).Foreground(lipgloss.Color("255")),
+	Warning: lipgloss.NewStyle().Background(lipgloss.Color("214")).Foreground(lipgloss.Color("0")),
+	Error:   lipgloss.NewStyle().Background(lipgloss.Color("160")).Foreground(lipgloss.Color("255")).Bold(true),
+}
+
+var levelIcons = map[Level]string{
+	Info:    "ℹ",
+	Warning: "⚠",
+	Error:   "✗",
+}
+
+// dismissMsg dismisses the banner with the given id, unless it has since
+// been replaced.
+type dismissMsg struct{ id int }
+
+// Model shows at most one banner at a time; showing another replaces it.
+type Model struct {
+	level   Level
+	text    string
+	visible bool
+	id      int
+	width   int
+}
+
+func New() Model {
+	return Model{}
+}
+
+// SetWidth sets the width the banner spans.
+func (m *Model) SetWidth(w int) {
+	m.width = w
+}
+
+// Show shows a banner, replacing any already shown. If timeout is non-zero
+// the returned command dismisses it after the timeout. Errors are never
+// dismissed automatically, so that they can't be missed.
+func (m *Model) Show(level Level, text string, timeout time.Duration) tea.Cmd {
+	m.id++
+	m.level, m.text, m.visible = level, text, true
+	if timeout == 0 || level == Error {
+		return nil
+	}
+	id := m.id
+	return tea.Tick(timeout, func(time.Time) tea.Msg {
+		return dismissMsg{id: id}
+	})
+}
+
+// Dismiss hides the banner.
+func (m *Model) Dismiss() {
+	m.visible = false
+}
+
+// Visible reports whether a banner is shown.
+func (m Model) Visible() bool {
+	return m.visible
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case dismissMsg:
+		// A banner that replaced this one has its own timeout, if any.
+		if msg.id == m.id {
+			m.visible = false
+		}
+	}
+	return m, nil
+}
+
+func (m Model) View() string {
+	if !m.visible {
+		return ""
+	}
+	style := levelStyles[m.level].Padding(0, 1)
+	if m.width > 0 {
+		style = style.Width(m.width)
+	}
+	return style.Render(levelIcons[m.level] + " " + m.text)
+}
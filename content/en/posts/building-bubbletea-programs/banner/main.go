@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"unordered/banner"
+)
+
+var helpStyle = lipgloss.NewStyle().Faint(true)
+
+type model struct {
+	banner  banner.Model
+	timeout time.Duration
+}
+
+func newModel(timeout time.Duration) model {
+	return model{banner: banner.New(), timeout: timeout}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "i":
+			return m, m.banner.Show(banner.Info, "A new version is available.", m.timeout)
+		case "w":
+			return m, m.banner.Show(banner.Warning, "Disk usage is above 80%.", m.timeout)
+		case "e":
+			return m, m.banner.Show(banner.Error, "Lost connection to the server.", m.timeout)
+		case "x":
+			m.banner.Dismiss()
+		}
+		return m, nil
+	case tea.WindowSizeMsg:
+		m.banner.SetWidth(msg.Width)
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.banner, cmd = m.banner.Update(msg)
+	return m, cmd
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	if m.banner.Visible() {
+		b.WriteString(m.banner.View() + "\n")
+	}
+	b.WriteString("\nThe rest of the program goes here.\n\n")
+	b.WriteString(helpStyle.Render("i/w/e: info/warning/error banner • x: dismiss • q: quit") + "\n")
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel(3 * time.Second))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
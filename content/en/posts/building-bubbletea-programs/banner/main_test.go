@@ -0,0 +1,98 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestBanners(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(200*time.Millisecond), teatest.WithInitialTermSize(80, 20))
+
+	tm.Type("w")
+
+	waitForString(t, tm, "⚠ Disk usage is above 80%.")
+
+	// The warning is dismissed after the timeout, leaving the rest of the
+	// program at the top.
+	waitForDismissed(t, tm)
+
+	tm.Type("e")
+
+	waitForString(t, tm, "✗ Lost connection to the server.")
+
+	// Errors aren't dismissed.
+	time.Sleep(500 * time.Millisecond)
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if !fm.banner.Visible() {
+		t.Error("expected error banner to persist")
+	}
+}
+
+func TestAutoDismiss(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(200*time.Millisecond), teatest.WithInitialTermSize(80, 20))
+
+	tm.Type("w")
+
+	waitForString(t, tm, "⚠ Disk usage is above 80%.")
+	waitForDismissed(t, tm)
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.banner.Visible() {
+		t.Errorf("expected warning to have been dismissed, got %q", fm.banner.View())
+	}
+}
+
+func TestReplace(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(300*time.Millisecond), teatest.WithInitialTermSize(80, 20))
+
+	tm.Type("w")
+
+	waitForString(t, tm, "⚠ Disk usage")
+
+	// Replace the warning before it times out. Its timeout mustn't dismiss
+	// the new banner early.
+	time.Sleep(200 * time.Millisecond)
+	tm.Type("i")
+	time.Sleep(200 * time.Millisecond)
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if !fm.banner.Visible() || !strings.Contains(fm.banner.View(), "A new version is available.") {
+		t.Errorf("expected info banner to still be shown, got %q", fm.banner.View())
+	}
+}
+
+// waitForDismissed waits for a frame that redraws the rest of the program
+// without the warning.
+func waitForDismissed(t *testing.T, tm *teatest.TestModel) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), "The rest of the program goes here.") && !strings.Contains(string(b), "⚠")
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// bellInterval is the minimum time between bells, so that a burst of events
+// doesn't produce a storm of them.
+const bellInterval = time.Second
+
+type (
+	taskDoneMsg struct{ id int }
+	taskErrMsg  struct {
+		id  int
+		err error
+	}
+)
+
+type model struct {
+	muted      bool
+	lastBell   time.Time
+	rung       int
+	suppressed int
+	tasks      int
+}
+
+func newModel() model {
+	return model{}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "m":
+			m.muted = !m.muted
+		case "t":
+			m.tasks++
+			return m, runTask(m.tasks, nil)
+		case "e":
+			m.tasks++
+			return m, runTask(m.tasks, errors.New("exit status 1"))
+		}
+	case taskDoneMsg:
+		return m, m.alert(fmt.Sprintf("task %d finished", msg.id))
+	case taskErrMsg:
+		return m, m.alert(fmt.Sprintf("task %d failed: %s", msg.id, msg.err))
+	}
+	return m, nil
+}
+
+// alert returns a command that prints the line above the program, ringing
+// the bell with it unless muted or a bell has rung too recently. The bell
+// goes out through the program, along with the next frame, so it can't
+// interleave with the renderer's own writes to the terminal.
+func (m *model) alert(line string) tea.Cmd {
+	if m.muted {
+		return tea.Println(line)
+	}
+	if time.Since(m.lastBell) < bellInterval {
+		m.suppressed++
+		return tea.Println(line)
+	}
+	m.lastBell = time.Now()
+	m.rung++
+	return tea.Println(line + "\a")
+}
+
+func runTask(id int, err error) tea.Cmd {
+	return tea.Tick(500*time.Millisecond, func(time.Time) tea.Msg {
+		if err != nil {
+			return taskErrMsg{id: id, err: err}
+		}
+		return taskDoneMsg{id: id}
+	})
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	sound := "on"
+	if m.muted {
+		sound = "muted"
+	}
+	fmt.Fprintf(&b, "bell: %s • rung: %d • suppressed: %d\n", sound, m.rung, m.suppressed)
+	b.WriteString("t: run task • e: run failing task • m: toggle mute • q: quit\n")
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestBell(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(), teatest.WithInitialTermSize(80, 24))
+
+	tm.Send(taskDoneMsg{id: 1})
+
+	waitForStrings(t, tm, "task 1 finished\a", "rung: 1")
+
+	// A burst of events straight after the bell rings it no more.
+	for i := range 5 {
+		tm.Send(taskErrMsg{id: i, err: errors.New("boom")})
+	}
+
+	out := waitForStrings(t, tm, "task 4 failed: boom", "suppressed: 5")
+
+	tm.Type("q")
+	rest, err := io.ReadAll(tm.FinalOutput(t, teatest.WithFinalTimeout(time.Second)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Count(out+string(rest), "\a"); got != 0 {
+		t.Errorf("expected no more bells, got %d", got)
+	}
+}
+
+func TestMuted(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(), teatest.WithInitialTermSize(80, 24))
+
+	tm.Type("m")
+
+	waitForStrings(t, tm, "bell: muted")
+
+	tm.Send(taskDoneMsg{id: 1})
+
+	out := waitForStrings(t, tm, "task 1 finished")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+	rest, err := io.ReadAll(tm.FinalOutput(t, teatest.WithFinalTimeout(time.Second)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out+string(rest), "\a") {
+		t.Error("expected no bell when muted")
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the output,
+// returning the output read while waiting.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) string {
+	var out string
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			out = string(b)
+			for _, s := range ss {
+				if !strings.Contains(out, s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+	return out
+}
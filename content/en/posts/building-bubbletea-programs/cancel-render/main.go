@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// renderedMsg is the result of rendering for a particular terminal size.
+type renderedMsg struct {
+	width, height int
+	content       string
+}
+
+type model struct {
+	width, height int
+	// delay simulates how long the render takes.
+	delay     time.Duration
+	content   string
+	inFlight  int
+	discarded int
+}
+
+func newModel(delay time.Duration) model {
+	return model{delay: delay}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.inFlight++
+		return m, render(msg.Width, msg.Height, m.delay)
+	case renderedMsg:
+		m.inFlight--
+		// The terminal may have been resized again since the render
+		// started, in which case the result is no longer any use.
+		if msg.width != m.width || msg.height != m.height {
+			m.discarded++
+			return m, nil
+		}
+		m.content = msg.content
+	}
+	return m, nil
+}
+
+// render performs an expensive render in a command so as not to block the
+// event loop. It fills the terminal, less a line for the status bar.
+func render(width, height int, delay time.Duration) tea.Cmd {
+	return func() tea.Msg {
+		time.Sleep(delay)
+		lines := make([]string, max(0, height-1))
+		for y := range lines {
+			var b strings.Builder
+			for x := range width {
+				if (x/4+y/2)%2 == 0 {
+					b.WriteString("░")
+				} else {
+					b.WriteString(" ")
+				}
+			}
+			lines[y] = b.String()
+		}
+		caption := fmt.Sprintf(" rendered for %dx%d ", width, height)
+		if len(lines) > 0 && len(caption) <= width {
+			lines[0] = caption + string([]rune(lines[0])[len(caption):])
+		}
+		return renderedMsg{width: width, height: height, content: strings.Join(lines, "\n")}
+	}
+}
+
+func (m model) View() string {
+	status := fmt.Sprintf("in flight: %d • discarded: %d • q: quit", m.inFlight, m.discarded)
+	if m.content == "" {
+		return "rendering...\n" + status
+	}
+	return m.content + "\n" + status
+}
+
+func main() {
+	p := tea.NewProgram(newModel(500*time.Millisecond), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestRapidResizes(t *testing.T) {
+	m := newModel(200 * time.Millisecond)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(40, 10))
+
+	for _, size := range []tea.WindowSizeMsg{
+		{Width: 50, Height: 12},
+		{Width: 60, Height: 14},
+		{Width: 70, Height: 16},
+	} {
+		tm.Send(size)
+	}
+
+	waitForString(t, tm, "in flight: 0 • discarded: 3")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if !strings.Contains(fm.content, "rendered for 70x16") {
+		t.Errorf("expected render for final size, got:\n%s", fm.content)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
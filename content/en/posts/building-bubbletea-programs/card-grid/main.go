@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// cardWidth is the width of a card, including its border.
+	cardWidth = 20
+	// gap is the number of columns between cards.
+	gap = 1
+)
+
+var (
+	cardStyle     = lipgloss.NewStyle().Width(cardWidth-2).Border(lipgloss.RoundedBorder()).Padding(0, 1)
+	selectedStyle = cardStyle.BorderForeground(lipgloss.Color("205"))
+)
+
+type card struct {
+	title, body string
+}
+
+type model struct {
+	cards  []card
+	cursor int
+	width  int
+}
+
+func newModel(cards []card) model {
+	return model{cards: cards}
+}
+
+// columns returns the number of cards that fit across the terminal, which
+// is zero if not even one fits.
+func (m model) columns() int {
+	return (m.width + gap) / (cardWidth + gap)
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Navigate as a single column when too narrow to show any cards,
+		// so the cursor is still somewhere sensible once there's room.
+		cols := max(1, m.columns())
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "left", "h":
+			if m.cursor%cols > 0 {
+				m.cursor--
+			}
+		case "right", "l":
+			if m.cursor%cols < cols-1 && m.cursor+1 < len(m.cards) {
+				m.cursor++
+			}
+		case "up", "k":
+			if m.cursor-cols >= 0 {
+				m.cursor -= cols
+			}
+		case "down", "j":
+			if m.cursor+cols < len(m.cards) {
+				m.cursor += cols
+			} else if m.cursor/cols < (len(m.cards)-1)/cols {
+				// The last row is partially filled and there's no card
+				// directly below, so move to the last one.
+				m.cursor = len(m.cards) - 1
+			}
+		}
+	case tea.WindowSizeMsg:
+		// The cursor is an index into the cards, so it survives the grid
+		// reflowing.
+		m.width = msg.Width
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	cols := m.columns()
+	if cols == 0 {
+		return fmt.Sprintf("Terminal too narrow: cards need at least %d columns.\n", cardWidth)
+	}
+	var rows []string
+	for start := 0; start < len(m.cards); start += cols {
+		var row []string
+		for i := start; i < min(start+cols, len(m.cards)); i++ {
+			style := cardStyle
+			if i == m.cursor {
+				style = selectedStyle
+			}
+			if i > start {
+				row = append(row, strings.Repeat(" ", gap))
+			}
+			row = append(row, style.Render(m.cards[i].title+"\n"+m.cards[i].body))
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, row...))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rows...) + "\n"
+}
+
+func main() {
+	var cards []card
+	for i := 1; i <= 10; i++ {
+		cards = append(cards, card{
+			title: fmt.Sprintf("Card %d", i),
+			body:  fmt.Sprintf("%d items", i*3),
+		})
+	}
+	p := tea.NewProgram(newModel(cards), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func cards(n int) []card {
+	var cards []card
+	for i := 1; i <= n; i++ {
+		cards = append(cards, card{title: fmt.Sprintf("Card %d", i), body: "body"})
+	}
+	return cards
+}
+
+func TestReflow(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(cards(10)), teatest.WithInitialTermSize(90, 40))
+
+	// Four columns, leaving two cards on the last row.
+	waitForString(t, tm, "Card 4")
+
+	// Move to the end of the first row, and try to go beyond it.
+	for range 5 {
+		tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+	}
+	// Down to card 8, then to card 10, the last card, since there is no
+	// card beneath card 8.
+	for range 3 {
+		tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	}
+
+	// Reflow into two columns; card 10 is at the end of its row.
+	tm.Send(tea.WindowSizeMsg{Width: 45, Height: 40})
+	tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.columns(); got != 2 {
+		t.Errorf("expected 2 columns, got %d", got)
+	}
+	if fm.cursor != 9 {
+		t.Errorf("expected cursor on card 10, got card %d", fm.cursor+1)
+	}
+}
+
+func TestTooNarrow(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(cards(3)), teatest.WithInitialTermSize(15, 40))
+
+	// The message is truncated to the width of the terminal.
+	waitForString(t, tm, "Terminal too")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Send(tea.WindowSizeMsg{Width: 20, Height: 40})
+
+	waitForString(t, tm, "Card 1")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.columns(); got != 1 {
+		t.Errorf("expected 1 column, got %d", got)
+	}
+	if fm.cursor != 2 {
+		t.Errorf("expected cursor on the last card, got card %d", fm.cursor+1)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// capacity is the number of results to keep cached.
+const capacity = 3
+
+var (
+	cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	hitStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+	missStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	errorStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	helpStyle   = lipgloss.NewStyle().Faint(true)
+)
+
+// cache holds the results of commands, keyed by their input, evicting the
+// least recently used result once it's full.
+type cache struct {
+	results map[string]string
+	// order lists the keys from least to most recently used.
+	order    []string
+	capacity int
+}
+
+func newCache(capacity int) *cache {
+	return &cache{results: make(map[string]string), capacity: capacity}
+}
+
+func (c *cache) get(key string) (string, bool) {
+	result, ok := c.results[key]
+	if ok {
+		c.touch(key)
+	}
+	return result, ok
+}
+
+func (c *cache) put(key, result string) {
+	c.results[key] = result
+	c.touch(key)
+	if len(c.order) > c.capacity {
+		delete(c.results, c.order[0])
+		c.order = c.order[1:]
+	}
+}
+
+func (c *cache) invalidate(key string) {
+	delete(c.results, key)
+	c.order = slices.DeleteFunc(c.order, func(k string) bool { return k == key })
+}
+
+// touch makes the key the most recently used.
+func (c *cache) touch(key string) {
+	c.order = slices.DeleteFunc(c.order, func(k string) bool { return k == key })
+	c.order = append(c.order, key)
+}
+
+type resultMsg struct {
+	city   string
+	result string
+	err    error
+}
+
+type model struct {
+	cities []string
+	cursor int
+	fetch  func(city string) (string, error)
+	cache  *cache
+
+	hits, misses int
+	// selected is the city whose result is shown.
+	selected string
+	// pending holds the cities with a fetch in progress, so that requesting
+	// one again doesn't issue a second fetch.
+	pending map[string]bool
+	err     error
+	spinner spinner.Model
+}
+
+func newModel(cities []string, fetch func(city string) (string, error)) model {
+	return model{
+		cities:  cities,
+		fetch:   fetch,
+		cache:   newCache(capacity),
+		pending: make(map[string]bool),
+		spinner: spinner.New(spinner.WithSpinner(spinner.MiniDot)),
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+// request shows the result for the city, from the cache if it's there,
+// otherwise by fetching it.
+func (m *model) request(city string) tea.Cmd {
+	m.selected, m.err = city, nil
+	if _, ok := m.cache.get(city); ok {
+		m.hits++
+		return nil
+	}
+	m.misses++
+	if m.pending[city] {
+		return nil
+	}
+	m.pending[city] = true
+	fetch := m.fetch
+	return tea.Batch(m.spinner.Tick, func() tea.Msg {
+		result, err := fetch(city)
+		return resultMsg{city: city, result: result, err: err}
+	})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = min(len(m.cities)-1, m.cursor+1)
+		case "enter":
+			return m, m.request(m.cities[m.cursor])
+		case "r":
+			// Refresh: throw away the cached result and fetch it again.
+			city := m.cities[m.cursor]
+			m.cache.invalidate(city)
+			return m, m.request(city)
+		}
+	case resultMsg:
+		delete(m.pending, msg.city)
+		if msg.err != nil {
+			// Errors aren't cached, so the next request tries again.
+			if msg.city == m.selected {
+				m.err = msg.err
+			}
+			return m, nil
+		}
+		m.cache.put(msg.city, msg.result)
+	case spinner.TickMsg:
+		if len(m.pending) == 0 {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	for i, city := range m.cities {
+		cached := "  "
+		if _, ok := m.cache.results[city]; ok {
+			cached = " •"
+		}
+		if i == m.cursor {
+			b.WriteString(cursorStyle.Render("> "+city) + cached + "\n")
+		} else {
+			b.WriteString("  " + city + cached + "\n")
+		}
+	}
+	b.WriteString("\n")
+	if m.selected != "" {
+		b.WriteString(m.selected + ": ")
+		if m.err != nil {
+			b.WriteString(errorStyle.Render(m.err.Error()))
+		} else if result, ok := m.cache.results[m.selected]; ok {
+			b.WriteString(result)
+		} else {
+			b.WriteString(m.spinner.View() + " fetching…")
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(hitStyle.Render(fmt.Sprintf("hits: %d", m.hits)) + "  " + missStyle.Render(fmt.Sprintf("misses: %d", m.misses)))
+	b.WriteString(fmt.Sprintf("  cached: %d/%d\n", len(m.cache.results), m.cache.capacity))
+	b.WriteString(helpStyle.Render("j/k: select • enter: look up • r: refresh • q: quit") + "\n")
+	return b.String()
+}
+
+func fetch(city string) (string, error) {
+	time.Sleep(time.Duration(500+rand.Intn(1500)) * time.Millisecond)
+	return fmt.Sprintf("%d°C", rand.Intn(35)), nil
+}
+
+func main() {
+	m := newModel([]string{"London", "Paris", "Berlin", "Madrid", "Rome"}, fetch)
+	p := tea.NewProgram(m)
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
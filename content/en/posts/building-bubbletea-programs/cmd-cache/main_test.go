@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+// fakeFetch records the cities it's asked to fetch.
+type fakeFetch struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (f *fakeFetch) fetch(city string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, city)
+	return fmt.Sprintf("%d°C", 10+len(f.calls)), nil
+}
+
+func (f *fakeFetch) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.calls)
+}
+
+func TestCacheHit(t *testing.T) {
+	f := &fakeFetch{}
+	tm := teatest.NewTestModel(t, newModel([]string{"London", "Paris"}, f.fetch), teatest.WithInitialTermSize(80, 20))
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForStrings(t, tm, "London: 11°C", "hits: 0", "misses: 1")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForStrings(t, tm, "hits: 1")
+
+	// Refreshing fetches it again.
+	tm.Type("r")
+
+	waitForStrings(t, tm, "London: 12°C", "misses: 2")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.hits != 1 || fm.misses != 2 {
+		t.Errorf("want 1 hit and 2 misses, got %d and %d", fm.hits, fm.misses)
+	}
+	if want := []string{"London", "London"}; !slices.Equal(f.Calls(), want) {
+		t.Errorf("want fetches %v, got %v", want, f.Calls())
+	}
+}
+
+func TestEviction(t *testing.T) {
+	c := newCache(2)
+	c.put("London", "11°C")
+	c.put("Paris", "12°C")
+	// London is now the most recently used, so Paris goes first.
+	c.get("London")
+	c.put("Berlin", "13°C")
+
+	if _, ok := c.get("Paris"); ok {
+		t.Error("expected Paris to be evicted")
+	}
+	for _, city := range []string{"London", "Berlin"} {
+		if _, ok := c.get(city); !ok {
+			t.Errorf("expected %s to be cached", city)
+		}
+	}
+	if len(c.results) != 2 {
+		t.Errorf("expected 2 cached results, got %d", len(c.results))
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sync/atomic"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type pingMsg struct{}
+
+type model struct {
+	// executed counts how many commands have actually been run. A command
+	// runs in its own go routine, so the count is shared via a pointer.
+	executed *atomic.Int64
+	updates  int
+	pings    int
+	last     string
+}
+
+func newModel() model {
+	return model{executed: new(atomic.Int64)}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m.updates++
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "1":
+			// The command runs and returns a message, which results in a
+			// further call to Update.
+			m.last = "command returning a message"
+			return m, func() tea.Msg {
+				m.executed.Add(1)
+				return pingMsg{}
+			}
+		case "2":
+			// The command runs but returns nil, so Bubbletea has nothing to
+			// send to Update.
+			m.last = "command returning nil"
+			return m, func() tea.Msg {
+				m.executed.Add(1)
+				return nil
+			}
+		case "3":
+			// There is no command at all, so nothing is run.
+			m.last = "nil command"
+			return m, nil
+		}
+	case pingMsg:
+		m.pings++
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	return fmt.Sprintf(
+		"last: %s\nupdates: %d\npings received: %d\ncommands executed: %d\n\n"+
+			"1: command returning a message\n2: command returning nil\n3: nil command\nq: quit\n",
+		m.last, m.updates, m.pings, m.executed.Load(),
+	)
+}
+
+func main() {
+	p := tea.NewProgram(newModel())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestCommandSemantics(t *testing.T) {
+	m := newModel()
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	// The key press and the ping it produces are both updates.
+	tm.Type("1")
+	waitForString(t, tm, "pings received: 1")
+
+	// Only the key press is an update; no ping follows.
+	tm.Type("2")
+	waitForString(t, tm, "last: command returning nil")
+
+	// The command runs in its own goroutine, after the update that returned
+	// it, so wait for it to have run. The counter is shared with the model
+	// being tested.
+	deadline := time.Now().Add(10 * time.Second)
+	for m.executed.Load() < 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the command returning nil to run")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	tm.Type("3")
+	waitForString(t, tm, "last: nil command")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.pings != 1 {
+		t.Errorf("expected 1 ping, got %d", fm.pings)
+	}
+	// window size, "1", ping, "2", "3", "q"
+	if fm.updates != 6 {
+		t.Errorf("expected 6 updates, got %d", fm.updates)
+	}
+	// Both commands ran, even though only one produced a message. The nil
+	// command never runs at all.
+	if got := fm.executed.Load(); got != 2 {
+		t.Errorf("expected 2 commands to have been executed, got %d", got)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
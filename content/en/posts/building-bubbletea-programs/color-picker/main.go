@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+const sliderWidth = 32
+
+var (
+	labelStyle  = lipgloss.NewStyle().Width(3)
+	cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	presets     = []rgb{
+		{0xff, 0x5f, 0x87},
+		{0x5f, 0xaf, 0xff},
+		{0x87, 0xd7, 0x87},
+		{0xff, 0xd7, 0x00},
+		{0x44, 0x44, 0x44},
+	}
+)
+
+type rgb [3]int
+
+func (c rgb) hex() string {
+	return fmt.Sprintf("#%02x%02x%02x", c[0], c[1], c[2])
+}
+
+// copiedMsg reports the result of copying a color, which may no longer be
+// the selected color by the time the copy finishes.
+type copiedMsg struct {
+	hex string
+	err error
+}
+
+type model struct {
+	renderer *lipgloss.Renderer
+	copy     func(string) error
+	color    rgb
+	focus    int
+	status   string
+}
+
+func newModel(profile termenv.Profile, copy func(string) error) model {
+	r := lipgloss.NewRenderer(os.Stdout)
+	r.SetColorProfile(profile)
+	return model{renderer: r, copy: copy, color: presets[0]}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		m.status = ""
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "tab", "down", "j":
+			m.focus = (m.focus + 1) % len(m.color)
+		case "shift+tab", "up", "k":
+			m.focus = (m.focus - 1 + len(m.color)) % len(m.color)
+		case "right", "l":
+			m.adjust(1)
+		case "left", "h":
+			m.adjust(-1)
+		case "shift+right", "L":
+			m.adjust(16)
+		case "shift+left", "H":
+			m.adjust(-16)
+		case "1", "2", "3", "4", "5":
+			m.color = presets[msg.String()[0]-'1']
+		case "y":
+			hex, copy := m.color.hex(), m.copy
+			return m, func() tea.Msg {
+				return copiedMsg{hex: hex, err: copy(hex)}
+			}
+		}
+	case copiedMsg:
+		if msg.err != nil {
+			m.status = "could not copy: " + msg.err.Error()
+		} else {
+			m.status = "copied " + msg.hex
+		}
+	}
+	return m, nil
+}
+
+// adjust changes the focused channel by delta, keeping it within 0-255.
+func (m *model) adjust(delta int) {
+	m.color[m.focus] = max(0, min(255, m.color[m.focus]+delta))
+}
+
+func (m model) View() string {
+	var sliders strings.Builder
+	for i, name := range []string{"R", "G", "B"} {
+		v := m.color[i]
+		filled := v * sliderWidth / 255
+		bar := strings.Repeat("█", filled) + strings.Repeat("░", sliderWidth-filled)
+		label := labelStyle.Render(name)
+		if i == m.focus {
+			label = cursorStyle.Inherit(labelStyle).Render(name)
+		}
+		fmt.Fprintf(&sliders, "%s %s %3d\n", label, bar, v)
+	}
+
+	swatch := m.renderer.NewStyle().
+		Background(lipgloss.Color(m.color.hex())).
+		Width(12).
+		Height(4).
+		Render("")
+
+	var presetBar []string
+	for i, p := range presets {
+		presetBar = append(presetBar, fmt.Sprintf("%d:", i+1)+m.renderer.NewStyle().Background(lipgloss.Color(p.hex())).Render("  "))
+	}
+
+	view := lipgloss.JoinHorizontal(lipgloss.Top, swatch, "  ", sliders.String()) + "\n" +
+		m.color.hex() + "\n\n" +
+		strings.Join(presetBar, " ") + "\n\n"
+	if m.status != "" {
+		view += m.status + "\n"
+	}
+	return view + "tab: channel • ←/→: adjust (shift: ×16) • 1-5: preset • y: copy • q: quit\n"
+}
+
+func main() {
+	p := tea.NewProgram(newModel(lipgloss.ColorProfile(), clipboard.WriteAll))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/muesli/termenv"
+)
+
+func TestAdjustRed(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		copied string
+	)
+	copy := func(s string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		copied = s
+		return nil
+	}
+	tm := teatest.NewTestModel(t, newModel(termenv.TrueColor, copy), teatest.WithInitialTermSize(80, 24))
+
+	// Pick the grey preset, and turn its red down to 0, which it's clamped
+	// to.
+	tm.Type("5")
+	for i := 0; i < 5; i++ {
+		tm.Send(tea.KeyMsg{Type: tea.KeyShiftLeft})
+	}
+
+	waitForString(t, tm, "#004444")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyShiftRight})
+	tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+
+	// The swatch's background updates too.
+	waitForStrings(t, tm, "#114444", "\x1b[48;2;17;68;68m")
+
+	// Red is clamped to 255.
+	for i := 0; i < 20; i++ {
+		tm.Send(tea.KeyMsg{Type: tea.KeyShiftRight})
+	}
+
+	waitForString(t, tm, "#ff4444")
+
+	tm.Type("y")
+
+	waitForString(t, tm, "copied #ff4444")
+
+	tm.Type("q")
+	tm.WaitFinished(t)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if copied != "#ff4444" {
+		t.Errorf("expected #ff4444 to be copied, got %q", copied)
+	}
+}
+
+func TestCopiedWhileChanging(t *testing.T) {
+	initial := newModel(termenv.TrueColor, nil)
+	initial.color = rgb{0, 0, 0}
+	var m tea.Model = initial
+	// The selection has moved on since the copy was made.
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftRight})
+	m, _ = m.Update(copiedMsg{hex: "#000000"})
+	if got := m.(model).status; got != "copied #000000" {
+		t.Errorf("expected status to name the copied color, got %q", got)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	waitForStrings(t, tm, s)
+}
+
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lucasb-eyer/go-colorful"
+	"github.com/muesli/termenv"
+)
+
+const gradientWidth = 40
+
+var profileNames = map[termenv.Profile]string{
+	termenv.TrueColor: "TrueColor",
+	termenv.ANSI256:   "ANSI256",
+	termenv.ANSI:      "ANSI",
+	termenv.Ascii:     "Ascii",
+}
+
+type model struct {
+	renderer *lipgloss.Renderer
+	detected termenv.Profile
+}
+
+func newModel(detected termenv.Profile) model {
+	r := lipgloss.NewRenderer(os.Stdout)
+	r.SetColorProfile(detected)
+	return model{renderer: r, detected: detected}
+}
+
+// detectProfile returns the color profile of the terminal, honouring the
+// NO_COLOR convention (https://no-color.org).
+func detectProfile() termenv.Profile {
+	if os.Getenv("NO_COLOR") != "" {
+		return termenv.Ascii
+	}
+	return lipgloss.ColorProfile()
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "p":
+			// Profiles are ordered from most to least capable, so
+			// incrementing forces a lower profile.
+			next := m.renderer.ColorProfile() + 1
+			if next > termenv.Ascii {
+				next = m.detected
+			}
+			m.renderer.SetColorProfile(next)
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "detected: %s\n", profileNames[m.detected])
+	fmt.Fprintf(&b, "profile:  %s\n\n", profileNames[m.renderer.ColorProfile()])
+	b.WriteString(m.gradient())
+	b.WriteString("\n\np: force lower profile • q: quit\n")
+	return b.String()
+}
+
+// gradient blends between two colors. Lipgloss converts each color to the
+// closest color supported by the renderer's profile.
+func (m model) gradient() string {
+	from, _ := colorful.Hex("#5A56E0")
+	to, _ := colorful.Hex("#EE6FF8")
+
+	var b strings.Builder
+	for i := range gradientWidth {
+		c := from.BlendLuv(to, float64(i)/float64(gradientWidth-1))
+		style := m.renderer.NewStyle().Background(lipgloss.Color(c.Hex()))
+		b.WriteString(style.Render(" "))
+	}
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel(detectProfile()))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
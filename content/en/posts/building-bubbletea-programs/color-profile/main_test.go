@@ -0,0 +1,58 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/muesli/termenv"
+)
+
+func TestForceANSI(t *testing.T) {
+	m := newModel(termenv.TrueColor)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "profile:  TrueColor")
+
+	tm.Type("p")
+
+	waitForString(t, tm, "profile:  ANSI256")
+
+	tm.Type("p")
+
+	// Bright blue background, one of the 16 ANSI colors.
+	waitForString(t, tm, "\x1b[104m")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	gradient := fm.gradient()
+	if !strings.Contains(gradient, "\x1b[") {
+		t.Error("expected gradient to be colored")
+	}
+	if strings.Contains(gradient, "48;2;") {
+		t.Errorf("expected no 24-bit color sequences, got %q", gradient)
+	}
+}
+
+func TestNoColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	m := newModel(detectProfile())
+	if strings.Contains(m.gradient(), "\x1b[") {
+		t.Error("expected monochrome gradient with NO_COLOR set")
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,48 @@
+// Package confirm provides a yes/no confirmation prompt component.
+package confirm
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Model is a yes/no prompt. Embed it in a parent model, forward messages to
+// Update, and check Chosen after each update to see if the user answered.
+type Model struct {
+	prompt   string
+	choice   bool
+	answered bool
+}
+
+// New returns a prompt asking the given question.
+func New(prompt string) Model {
+	return Model{prompt: prompt}
+}
+
+// Chosen returns the user's choice, and whether they have made one yet.
+func (m Model) Chosen() (bool, bool) {
+	return m.choice, m.answered
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if m.answered {
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "y", "Y":
+			m.choice = true
+			m.answered = true
+		case "n", "N", "enter", "esc":
+			// Enter selects the default, and Esc cancels, both of which
+			// mean no.
+			m.choice = false
+			m.answered = true
+		}
+	}
+	return m, nil
+}
+
+func (m Model) View() string {
+	return m.prompt + " (y/N)"
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"unordered/confirm"
+)
+
+type model struct {
+	confirm    confirm.Model
+	confirming bool
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.confirming {
+		var cmd tea.Cmd
+		m.confirm, cmd = m.confirm.Update(msg)
+		if yes, ok := m.confirm.Chosen(); ok {
+			if yes {
+				return m, tea.Quit
+			}
+			m.confirming = false
+		}
+		return m, cmd
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case msg.String() == "ctrl+c":
+			m.confirm = confirm.New("Quit?")
+			m.confirming = true
+			return m, nil
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.confirming {
+		return m.confirm.View()
+	}
+	return "Running."
+}
+
+func main() {
+	p := tea.NewProgram(model{})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var modalStyle = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+
+type model struct {
+	count int
+	// confirming is true while asking whether to quit.
+	confirming bool
+	// modal is true while the help modal is open.
+	modal bool
+	// confirmed is true if the user chose to quit, rather than forcing it.
+	confirmed bool
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			switch {
+			case m.confirming:
+				// A second ctrl+c forces the issue, in case the program
+				// is stuck or the user is impatient.
+				return m, tea.Quit
+			case m.modal:
+				// Close the modal first, so the prompt isn't shown on
+				// top of it.
+				m.modal = false
+			default:
+				m.confirming = true
+			}
+			return m, nil
+		}
+		if m.confirming {
+			if msg.String() == "y" {
+				m.confirmed = true
+				return m, tea.Quit
+			}
+			// Anything else declines.
+			m.confirming = false
+			return m, nil
+		}
+		if m.modal {
+			if msg.String() == "esc" || msg.String() == "?" {
+				m.modal = false
+			}
+			return m, nil
+		}
+		switch msg.String() {
+		case "+":
+			m.count++
+		case "?":
+			m.modal = true
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	switch {
+	case m.confirming:
+		return "Quit? (y/N, ctrl+c to force)"
+	case m.modal:
+		return modalStyle.Render("Help\n\n+: increment\nctrl+c: quit\nesc: close")
+	default:
+		return fmt.Sprintf("Running. Count: %d\n\n+: increment • ?: help • ctrl+c: quit", m.count)
+	}
+}
+
+func main() {
+	p := tea.NewProgram(model{})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestConfirmQuit(t *testing.T) {
+	tm := teatest.NewTestModel(t, model{})
+
+	waitForString(t, tm, "Running.")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	waitForString(t, tm, "Quit? (y/N")
+
+	// Declining carries on as if nothing happened.
+	tm.Type("n")
+	tm.Type("+")
+
+	waitForString(t, tm, "Running. Count: 1")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	waitForString(t, tm, "Quit? (y/N")
+
+	tm.Type("y")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if !fm.confirmed {
+		t.Error("expected quit to have been confirmed")
+	}
+}
+
+func TestForceQuit(t *testing.T) {
+	tm := teatest.NewTestModel(t, model{})
+
+	waitForString(t, tm, "Running.")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	waitForString(t, tm, "Quit? (y/N")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.confirmed {
+		t.Error("expected quit to have been forced")
+	}
+}
+
+func TestCloseModalFirst(t *testing.T) {
+	tm := teatest.NewTestModel(t, model{})
+
+	tm.Type("?")
+
+	waitForString(t, tm, "Help")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	waitForString(t, tm, "Running.")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	waitForString(t, tm, "Quit? (y/N")
+
+	tm.Type("y")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// interval is how often the connection is checked while connected.
+	interval = 2 * time.Second
+	// minBackoff and maxBackoff bound the wait between reconnect attempts,
+	// which doubles after each failed attempt.
+	minBackoff = time.Second
+	maxBackoff = 30 * time.Second
+	// threshold is the number of consecutive failed checks before a
+	// connection is considered lost, so that a single dropped check doesn't
+	// flip the badge back and forth.
+	threshold = 2
+)
+
+var (
+	badgeStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("0")).Padding(0, 1)
+	badgeColors = map[state]lipgloss.Color{
+		connected:    lipgloss.Color("10"),
+		connecting:   lipgloss.Color("214"),
+		disconnected: lipgloss.Color("9"),
+	}
+	titleStyle = lipgloss.NewStyle().Bold(true)
+	helpStyle  = lipgloss.NewStyle().Faint(true)
+)
+
+type state int
+
+const (
+	connecting state = iota
+	connected
+	disconnected
+)
+
+func (s state) String() string {
+	switch s {
+	case connected:
+		return "connected"
+	case disconnected:
+		return "disconnected"
+	default:
+		return "connecting"
+	}
+}
+
+// checkMsg is the result of a health check or reconnect attempt.
+type checkMsg struct {
+	id  int
+	err error
+}
+
+// nextMsg is sent when it's time for the next check or reconnect attempt.
+type nextMsg struct{ id int }
+
+type model struct {
+	check func() error
+	state state
+	// id identifies the current chain of checks. A manual reconnect starts a
+	// new chain, and messages from the old one are ignored.
+	id       int
+	failures int
+	backoff  time.Duration
+	// retryIn is how long until the next reconnect attempt.
+	retryIn time.Duration
+	err     error
+
+	interval, minBackoff, maxBackoff time.Duration
+}
+
+func newModel(check func() error) model {
+	return model{
+		check:      check,
+		backoff:    minBackoff,
+		interval:   interval,
+		minBackoff: minBackoff,
+		maxBackoff: maxBackoff,
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.runCheck()
+}
+
+func (m model) runCheck() tea.Cmd {
+	id, check := m.id, m.check
+	return func() tea.Msg {
+		return checkMsg{id: id, err: check()}
+	}
+}
+
+func (m model) after(d time.Duration) tea.Cmd {
+	id := m.id
+	return tea.Tick(d, func(time.Time) tea.Msg {
+		return nextMsg{id: id}
+	})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "r":
+			if m.state != disconnected {
+				return m, nil
+			}
+			// Reconnect now, rather than wait out the backoff.
+			m.id++
+			m.state = connecting
+			m.backoff = m.minBackoff
+			return m, m.runCheck()
+		}
+	case checkMsg:
+		if msg.id != m.id {
+			return m, nil
+		}
+		if msg.err == nil {
+			m.state, m.failures, m.err = connected, 0, nil
+			m.backoff = m.minBackoff
+			return m, m.after(m.interval)
+		}
+		m.err = msg.err
+		if m.state == connected {
+			m.failures++
+			if m.failures < threshold {
+				return m, m.after(m.interval)
+			}
+		}
+		m.state = disconnected
+		m.retryIn = m.backoff
+		m.backoff = min(m.backoff*2, m.maxBackoff)
+		return m, m.after(m.retryIn)
+	case nextMsg:
+		if msg.id != m.id {
+			return m, nil
+		}
+		if m.state == disconnected {
+			m.state = connecting
+		}
+		return m, m.runCheck()
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	badge := badgeStyle.Background(badgeColors[m.state]).Render("● " + m.state.String())
+	s := titleStyle.Render("api.example.com") + "  " + badge + "\n\n"
+	switch {
+	case m.state == disconnected:
+		s += fmt.Sprintf("%s: retrying in %s\n", m.err, m.retryIn)
+	case m.state == connected && m.failures > 0:
+		s += fmt.Sprintf("check failed (%d of %d): %s\n", m.failures, threshold, m.err)
+	default:
+		s += "\n"
+	}
+	return s + "\n" + helpStyle.Render("r: reconnect now • q: quit") + "\n"
+}
+
+// check pretends to check the health of a flaky connection.
+func check() error {
+	time.Sleep(300 * time.Millisecond)
+	if rand.Intn(3) == 0 {
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+func main() {
+	p := tea.NewProgram(newModel(check))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+// fakeConn is a connection whose checks block until the test sends their
+// result.
+type fakeConn struct {
+	results chan error
+}
+
+func (f *fakeConn) check() error {
+	return <-f.results
+}
+
+func TestReconnect(t *testing.T) {
+	conn := &fakeConn{results: make(chan error)}
+	m := newModel(conn.check)
+	m.minBackoff, m.backoff = 500*time.Millisecond, 500*time.Millisecond
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 10))
+
+	conn.results <- errors.New("connection refused")
+
+	waitForStrings(t, tm, "● disconnected", "connection refused: retrying in 500ms")
+
+	// The reconnect attempt blocks until it's given a result.
+	waitForStrings(t, tm, "● connecting")
+
+	conn.results <- nil
+
+	waitForStrings(t, tm, "● connected")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.state != connected {
+		t.Errorf("expected connected, got %s", fm.state)
+	}
+}
+
+func TestDebounce(t *testing.T) {
+	var m tea.Model = newModel(nil)
+	m, _ = m.Update(checkMsg{})
+
+	// A single failed check leaves the connection up...
+	m, _ = m.Update(checkMsg{err: errors.New("timeout")})
+	if got := m.(model).state; got != connected {
+		t.Errorf("expected connected after one failure, got %s", got)
+	}
+	// ...and a successful one resets the count.
+	m, _ = m.Update(checkMsg{})
+	m, _ = m.Update(checkMsg{err: errors.New("timeout")})
+	if got := m.(model).state; got != connected {
+		t.Errorf("expected connected after a success, got %s", got)
+	}
+
+	m, _ = m.Update(checkMsg{err: errors.New("timeout")})
+	if got := m.(model).state; got != disconnected {
+		t.Errorf("expected disconnected after %d failures, got %s", threshold, got)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	var m tea.Model = newModel(nil)
+	for _, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		m, _ = m.Update(checkMsg{err: errors.New("connection refused")})
+		if got := m.(model).retryIn; got != want {
+			t.Errorf("want retry in %s, got %s", want, got)
+		}
+		m, _ = m.Update(nextMsg{})
+	}
+}
+
+func TestManualReconnect(t *testing.T) {
+	var m tea.Model = newModel(nil)
+	m, _ = m.Update(checkMsg{err: errors.New("connection refused")})
+	m, _ = m.Update(nextMsg{})
+	m, _ = m.Update(checkMsg{err: errors.New("connection refused")})
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if cmd == nil {
+		t.Fatal("expected a reconnect attempt")
+	}
+	if got := m.(model).state; got != connecting {
+		t.Errorf("expected connecting, got %s", got)
+	}
+	if got := m.(model).backoff; got != minBackoff {
+		t.Errorf("expected backoff to be reset, got %s", got)
+	}
+
+	// The retry scheduled before the manual reconnect is ignored.
+	_, cmd = m.Update(nextMsg{id: 0})
+	if cmd != nil {
+		t.Error("expected stale retry to be ignored")
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
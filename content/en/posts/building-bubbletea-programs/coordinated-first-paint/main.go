@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	headerStyle  = lipgloss.NewStyle().Background(lipgloss.Color("62")).Foreground(lipgloss.Color("230")).Padding(0, 1)
+	loadingStyle = lipgloss.NewStyle().Faint(true)
+	errorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+)
+
+type loadedMsg struct {
+	services []string
+	err      error
+}
+
+type model struct {
+	load func() ([]string, error)
+
+	// width and height are zero until the first window size message.
+	width, height int
+	// loaded is true once the data has loaded, successfully or not.
+	loaded   bool
+	services []string
+	err      error
+}
+
+func newModel(load func() ([]string, error)) model {
+	return model{load: load}
+}
+
+func (m model) Init() tea.Cmd {
+	return func() tea.Msg {
+		services, err := m.load()
+		return loadedMsg{services: services, err: err}
+	}
+}
+
+// ready reports whether both the size of the terminal and the data are
+// known. Until then, the real UI would be rendered at the wrong size or
+// without its content, only to be replaced moments later.
+func (m model) ready() bool {
+	return m.width > 0 && m.loaded
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+	case loadedMsg:
+		m.loaded = true
+		m.services, m.err = msg.services, msg.err
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	// Either may arrive first, so the same loading screen is shown
+	// whichever is still missing.
+	if !m.ready() {
+		return loadingStyle.Render("loading…") + "\n"
+	}
+	header := headerStyle.Width(m.width).Render(fmt.Sprintf("services (%d)", len(m.services)))
+	if m.err != nil {
+		return header + "\n\n" + errorStyle.Render("could not load services: "+m.err.Error()) + "\n"
+	}
+	var b strings.Builder
+	b.WriteString(header + "\n\n")
+	for _, s := range m.services {
+		b.WriteString("  " + s + "\n")
+	}
+	return b.String()
+}
+
+func load() ([]string, error) {
+	time.Sleep(time.Second)
+	return []string{"api", "auth", "billing", "search", "worker"}, nil
+}
+
+func main() {
+	p := tea.NewProgram(newModel(load), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
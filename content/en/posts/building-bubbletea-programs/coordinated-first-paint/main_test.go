@@ -0,0 +1,120 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+var services = []string{"api", "auth", "billing"}
+
+func TestFirstPaint(t *testing.T) {
+	release := make(chan struct{})
+	load := func() ([]string, error) {
+		<-release
+		return services, nil
+	}
+	tm := teatest.NewTestModel(t, newModel(load), teatest.WithInitialTermSize(40, 10))
+
+	// The size is known but the data is not.
+	waitForStrings(t, tm, "loading…")
+
+	close(release)
+
+	waitForStrings(t, tm, "services (3)", "api", "auth", "billing")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+// signalLoaded wraps the model, sending its view to loaded once it has
+// handled the loaded data.
+type signalLoaded struct {
+	tea.Model
+	loaded chan string
+}
+
+func (s signalLoaded) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m, cmd := s.Model.Update(msg)
+	if _, ok := msg.(loadedMsg); ok {
+		s.loaded <- m.View()
+	}
+	return signalLoaded{Model: m, loaded: s.loaded}, cmd
+}
+
+func TestFirstPaintDataBeforeSize(t *testing.T) {
+	load := func() ([]string, error) {
+		return services, nil
+	}
+	m := signalLoaded{Model: newModel(load), loaded: make(chan string, 1)}
+	// Without an initial size, no tea.WindowSizeMsg is sent until the test
+	// sends one.
+	tm := teatest.NewTestModel(t, m)
+
+	waitForStrings(t, tm, "loading…")
+
+	// The data has loaded, but the loading screen stays until the size is
+	// known.
+	view := <-m.loaded
+	if !strings.Contains(view, "loading…") || strings.Contains(view, "services (3)") {
+		t.Fatalf("expected loading screen once the data has loaded:\n%s", view)
+	}
+	tm.Send(tea.WindowSizeMsg{Width: 40, Height: 10})
+
+	waitForStrings(t, tm, "services (3)", "api", "auth", "billing")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(signalLoaded)
+	if !fm.Model.(model).ready() {
+		t.Error("expected model to be ready")
+	}
+}
+
+func TestDataBeforeSize(t *testing.T) {
+	var m tea.Model = newModel(nil)
+	m, _ = m.Update(loadedMsg{services: services})
+	if view := m.View(); !strings.Contains(view, "loading…") {
+		t.Errorf("expected loading screen until the size is known:\n%s", view)
+	}
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 40, Height: 10})
+	if view := m.View(); !strings.Contains(view, "services (3)") {
+		t.Errorf("expected real UI:\n%s", view)
+	}
+}
+
+func TestSizeBeforeData(t *testing.T) {
+	var m tea.Model = newModel(nil)
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 40, Height: 10})
+	if view := m.View(); !strings.Contains(view, "loading…") {
+		t.Errorf("expected loading screen until the data is loaded:\n%s", view)
+	}
+	m, _ = m.Update(loadedMsg{err: errors.New("timeout")})
+	if view := m.View(); !strings.Contains(view, "could not load services: timeout") {
+		t.Errorf("expected error in real UI:\n%s", view)
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
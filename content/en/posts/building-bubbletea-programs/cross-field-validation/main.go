@@ -0,0 +1,174 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const dateLayout = "2006-01-02"
+
+var (
+	labelStyle   = lipgloss.NewStyle().Width(18)
+	errorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	successStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+)
+
+const (
+	password = iota
+	confirmPassword
+	startDate
+	endDate
+)
+
+var labels = []string{"Password", "Confirm password", "Start date", "End date"}
+
+// formErrors holds the outcome of validating the form: an error per field, and
+// errors that concern more than one field.
+type formErrors struct {
+	fields [4]string
+	form   []string
+}
+
+func (e formErrors) ok() bool {
+	return e.fields == [4]string{} && len(e.form) == 0
+}
+
+// validate checks each field on its own, and only then checks fields
+// against each other: there's no point complaining that two passwords don't
+// match when one of them is missing.
+func validate(values [4]string) formErrors {
+	var errs formErrors
+	for i, v := range values {
+		if strings.TrimSpace(v) == "" {
+			errs.fields[i] = "required"
+		}
+	}
+	var start, end time.Time
+	for _, i := range []int{startDate, endDate} {
+		if errs.fields[i] != "" {
+			continue
+		}
+		t, err := time.Parse(dateLayout, strings.TrimSpace(values[i]))
+		if err != nil {
+			errs.fields[i] = "must be YYYY-MM-DD"
+			continue
+		}
+		if i == startDate {
+			start = t
+		} else {
+			end = t
+		}
+	}
+	if errs.fields[password] == "" && errs.fields[confirmPassword] == "" && values[password] != values[confirmPassword] {
+		errs.form = append(errs.form, "passwords do not match")
+	}
+	if errs.fields[startDate] == "" && errs.fields[endDate] == "" && !end.After(start) {
+		errs.form = append(errs.form, "end date must be after start date")
+	}
+	return errs
+}
+
+type model struct {
+	inputs    []textinput.Model
+	focus     int
+	errs      formErrors
+	attempted bool
+	submitted bool
+}
+
+func newModel() model {
+	inputs := make([]textinput.Model, len(labels))
+	for i := range inputs {
+		inputs[i] = textinput.New()
+		inputs[i].Prompt = ""
+		inputs[i].Width = 20
+	}
+	inputs[password].EchoMode = textinput.EchoPassword
+	inputs[confirmPassword].EchoMode = textinput.EchoPassword
+	inputs[startDate].Placeholder = "YYYY-MM-DD"
+	inputs[endDate].Placeholder = "YYYY-MM-DD"
+	inputs[0].Focus()
+	return model{inputs: inputs}
+}
+
+func (m model) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m model) values() [4]string {
+	var values [4]string
+	for i, in := range m.inputs {
+		values[i] = in.Value()
+	}
+	return values
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "esc":
+			return m, tea.Quit
+		case "tab", "down":
+			return m, m.setFocus((m.focus + 1) % len(m.inputs))
+		case "shift+tab", "up":
+			return m, m.setFocus((m.focus - 1 + len(m.inputs)) % len(m.inputs))
+		case "enter":
+			m.attempted = true
+			m.errs = validate(m.values())
+			if !m.errs.ok() {
+				return m, nil
+			}
+			m.submitted = true
+			return m, tea.Quit
+		}
+	}
+	var cmd tea.Cmd
+	m.inputs[m.focus], cmd = m.inputs[m.focus].Update(msg)
+	if m.attempted {
+		// Once the user has tried to submit, keep the errors up to date
+		// as they fix them.
+		m.errs = validate(m.values())
+	}
+	return m, cmd
+}
+
+func (m *model) setFocus(i int) tea.Cmd {
+	m.inputs[m.focus].Blur()
+	m.focus = i
+	return m.inputs[m.focus].Focus()
+}
+
+func (m model) View() string {
+	if m.submitted {
+		return successStyle.Render("Account created.") + "\n"
+	}
+	var b strings.Builder
+	for i, in := range m.inputs {
+		b.WriteString(labelStyle.Render(labels[i]) + in.View())
+		if err := m.errs.fields[i]; err != "" {
+			b.WriteString(" " + errorStyle.Render(err))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	for _, err := range m.errs.form {
+		b.WriteString(errorStyle.Render("✗ "+err) + "\n")
+	}
+	b.WriteString("tab: next field • enter: submit • esc: quit\n")
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestSubmit(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(), teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "Confirm password")
+
+	tm.Type("secret")
+	tm.Send(tea.KeyMsg{Type: tea.KeyTab})
+	tm.Type("secrex")
+	tm.Send(tea.KeyMsg{Type: tea.KeyTab})
+	tm.Type("2024-01-01")
+	tm.Send(tea.KeyMsg{Type: tea.KeyTab})
+	tm.Type("2024-02-01")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "passwords do not match")
+
+	// Go back to the confirm password field and fix the typo.
+	tm.Send(tea.KeyMsg{Type: tea.KeyShiftTab})
+	tm.Send(tea.KeyMsg{Type: tea.KeyShiftTab})
+	tm.Send(tea.KeyMsg{Type: tea.KeyBackspace})
+	tm.Type("t")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "Account created.")
+
+	fm := tm.FinalModel(t).(model)
+	if !fm.submitted {
+		t.Error("expected form to be submitted")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		values [4]string
+		fields [4]string
+		form   []string
+	}{
+		{
+			name:   "empty",
+			fields: [4]string{"required", "required", "required", "required"},
+		},
+		{
+			name:   "whitespace only",
+			values: [4]string{"  ", "  ", " ", "\t"},
+			fields: [4]string{"required", "required", "required", "required"},
+		},
+		{
+			name:   "one password missing",
+			values: [4]string{"secret", "", "2024-01-01", "2024-01-02"},
+			fields: [4]string{"", "required", "", ""},
+		},
+		{
+			name:   "invalid date",
+			values: [4]string{"secret", "secret", "yesterday", "2024-01-02"},
+			fields: [4]string{"", "", "must be YYYY-MM-DD", ""},
+		},
+		{
+			name:   "end same as start",
+			values: [4]string{"secret", "secret", "2024-01-01", "2024-01-01"},
+			form:   []string{"end date must be after start date"},
+		},
+		{
+			name:   "both mismatched",
+			values: [4]string{"secret", "secrex", "2024-01-02", "2024-01-01"},
+			form:   []string{"passwords do not match", "end date must be after start date"},
+		},
+		{
+			name:   "valid",
+			values: [4]string{"secret", "secret", " 2024-01-01 ", "2024-01-02"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validate(tt.values)
+			if errs.fields != tt.fields {
+				t.Errorf("expected field errors %q, got %q", tt.fields, errs.fields)
+			}
+			if !slices.Equal(errs.form, tt.form) {
+				t.Errorf("expected form errors %q, got %q", tt.form, errs.form)
+			}
+		})
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			Padding(0, 1)
+	titleStyle = lipgloss.NewStyle().Bold(true)
+	errorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+)
+
+type widget struct {
+	title    string
+	interval time.Duration
+	fetch    func() (string, error)
+
+	content   string
+	err       error
+	refreshes int
+}
+
+type (
+	// tickMsg tells the widget with the given id it is time to refresh.
+	tickMsg struct{ id int }
+	// refreshMsg carries the result of refreshing the widget with the given
+	// id.
+	refreshMsg struct {
+		id      int
+		content string
+		err     error
+	}
+)
+
+type model struct {
+	widgets       []widget
+	width, height int
+}
+
+func (m model) Init() tea.Cmd {
+	cmds := make([]tea.Cmd, len(m.widgets))
+	for id := range m.widgets {
+		cmds[id] = m.refresh(id)
+	}
+	return tea.Batch(cmds...)
+}
+
+// refresh fetches the widget's content in a command, so that a slow widget
+// doesn't hold up the others.
+func (m model) refresh(id int) tea.Cmd {
+	fetch := m.widgets[id].fetch
+	return func() tea.Msg {
+		content, err := fetch()
+		return refreshMsg{id: id, content: content, err: err}
+	}
+}
+
+func (m model) tick(id int) tea.Cmd {
+	return tea.Tick(m.widgets[id].interval, func(time.Time) tea.Msg {
+		return tickMsg{id: id}
+	})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	case tickMsg:
+		return m, m.refresh(msg.id)
+	case refreshMsg:
+		w := &m.widgets[msg.id]
+		w.refreshes++
+		w.err = msg.err
+		if msg.err == nil {
+			w.content = msg.content
+		}
+		return m, m.tick(msg.id)
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.width == 0 {
+		return ""
+	}
+	cols := 1
+	if m.width >= 80 {
+		cols = 2
+	}
+	width := m.width / cols
+
+	var rows []string
+	for i := 0; i < len(m.widgets); i += cols {
+		var boxes []string
+		for _, w := range m.widgets[i:min(i+cols, len(m.widgets))] {
+			boxes = append(boxes, m.widgetView(w, width))
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, boxes...))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+func (m model) widgetView(w widget, width int) string {
+	body := w.content
+	if w.err != nil {
+		body = errorStyle.Render("error: " + w.err.Error())
+	} else if w.refreshes == 0 {
+		body = "loading..."
+	}
+	header := titleStyle.Render(w.title) + fmt.Sprintf(" (#%d)", w.refreshes)
+	return boxStyle.
+		Width(width - boxStyle.GetHorizontalBorderSize()).
+		Height(6).
+		Render(header + "\n" + body)
+}
+
+func clock() (string, error) {
+	return time.Now().Format(time.TimeOnly), nil
+}
+
+func counter() func() (string, error) {
+	var n int
+	return func() (string, error) {
+		// Simulate an unreliable API.
+		if rand.Intn(4) == 0 {
+			return "", errors.New("request timed out")
+		}
+		n += rand.Intn(10)
+		return fmt.Sprintf("%d requests", n), nil
+	}
+}
+
+func logTail() func() (string, error) {
+	var lines []string
+	return func() (string, error) {
+		lines = append(lines, fmt.Sprintf("%s GET /api/items 200", time.Now().Format(time.TimeOnly)))
+		if len(lines) > 4 {
+			lines = lines[1:]
+		}
+		return strings.Join(lines, "\n"), nil
+	}
+}
+
+func main() {
+	m := model{widgets: []widget{
+		{title: "Clock", interval: time.Second, fetch: clock},
+		{title: "Requests", interval: 2 * time.Second, fetch: counter()},
+		{title: "Log", interval: 700 * time.Millisecond, fetch: logTail()},
+	}}
+	p := tea.NewProgram(m, tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestWidgets(t *testing.T) {
+	var n int
+	m := model{widgets: []widget{
+		{title: "Counter", interval: 10 * time.Millisecond, fetch: func() (string, error) {
+			n++
+			return fmt.Sprintf("count %d", n), nil
+		}},
+		{title: "Broken", interval: time.Hour, fetch: func() (string, error) {
+			return "", errors.New("service unavailable")
+		}},
+		{title: "Static", interval: time.Hour, fetch: func() (string, error) {
+			return "hello", nil
+		}},
+	}}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(100, 30))
+
+	waitForString(t, tm, "count 3")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	view := fm.View()
+	for _, want := range []string{"Counter", "Broken", "error: service unavailable", "Static", "hello"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected view to contain %q, got:\n%s", want, view)
+		}
+	}
+	if fm.widgets[0].refreshes < 3 {
+		t.Errorf("expected counter to refresh at least 3 times, got %d", fm.widgets[0].refreshes)
+	}
+	if fm.widgets[2].refreshes != 1 {
+		t.Errorf("expected static widget to refresh once, got %d", fm.widgets[2].refreshes)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	breadcrumbStyle = lipgloss.NewStyle().Bold(true).MarginBottom(1)
+	cursorStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	noticeStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+)
+
+type node struct {
+	name     string
+	children []*node
+}
+
+func (n *node) child(name string) *node {
+	for _, c := range n.children {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+type model struct {
+	// stack is the path from the root to the current node.
+	stack  []*node
+	cursor int
+	notice string
+}
+
+// newModel returns a model navigated to the given slash-separated path. The
+// path is followed as far as it is valid; any remainder is reported.
+func newModel(root *node, path string) model {
+	m := model{stack: []*node{root}}
+	segments := strings.FieldsFunc(path, func(r rune) bool { return r == '/' })
+	for i, name := range segments {
+		if !m.open(name) {
+			m.notice = fmt.Sprintf("could not open %q", strings.Join(segments[i:], "/"))
+			break
+		}
+	}
+	return m
+}
+
+func (m model) current() *node {
+	return m.stack[len(m.stack)-1]
+}
+
+// open navigates to the named child of the current node, as if the user had
+// selected it, reporting whether it exists.
+func (m *model) open(name string) bool {
+	c := m.current().child(name)
+	if c == nil {
+		return false
+	}
+	m.stack = append(m.stack, c)
+	m.cursor = 0
+	return true
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		m.notice = ""
+		children := m.current().children
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = max(0, min(len(children)-1, m.cursor+1))
+		case "enter", "right", "l":
+			if len(children) > 0 {
+				m.open(children[m.cursor].name)
+			}
+		case "backspace", "left", "h":
+			if len(m.stack) > 1 {
+				m.stack = m.stack[:len(m.stack)-1]
+				m.cursor = 0
+			}
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	names := make([]string, len(m.stack))
+	for i, n := range m.stack {
+		names[i] = n.name
+	}
+
+	var b strings.Builder
+	b.WriteString(breadcrumbStyle.Render(strings.Join(names, " / ")))
+	b.WriteString("\n")
+	children := m.current().children
+	if len(children) == 0 {
+		b.WriteString("  (empty)\n")
+	}
+	for i, c := range children {
+		if i == m.cursor {
+			b.WriteString(cursorStyle.Render("> " + c.name))
+		} else {
+			b.WriteString("  " + c.name)
+		}
+		b.WriteString("\n")
+	}
+	if m.notice != "" {
+		b.WriteString("\n" + noticeStyle.Render(m.notice) + "\n")
+	}
+	b.WriteString("\nenter: open • backspace: back • q: quit\n")
+	return b.String()
+}
+
+func catalog() *node {
+	return &node{name: "catalog", children: []*node{
+		{name: "electronics", children: []*node{
+			{name: "phones", children: []*node{{name: "android"}, {name: "iphone"}}},
+			{name: "laptops", children: []*node{{name: "ultrabooks"}, {name: "gaming"}}},
+		}},
+		{name: "books", children: []*node{
+			{name: "fiction"},
+			{name: "non-fiction"},
+		}},
+		{name: "garden"},
+	}}
+}
+
+func main() {
+	open := flag.String("open", "", "path to open on startup, e.g. electronics/phones")
+	flag.Parse()
+
+	p := tea.NewProgram(newModel(catalog(), *open))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
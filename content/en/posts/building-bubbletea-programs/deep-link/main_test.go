@@ -0,0 +1,61 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestDeepLink(t *testing.T) {
+	m := newModel(catalog(), "electronics/phones")
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "catalog / electronics / phones")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.current().name; got != "phones" {
+		t.Errorf("expected to open phones, got %q", got)
+	}
+}
+
+func TestPartialPath(t *testing.T) {
+	m := newModel(catalog(), "electronics/tablets/ipad")
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, `could not open "tablets/ipad"`)
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.current().name; got != "electronics" {
+		t.Errorf("expected to get as far as electronics, got %q", got)
+	}
+}
+
+func TestEmptyPath(t *testing.T) {
+	for _, path := range []string{"", "/"} {
+		m := newModel(catalog(), path)
+		if got := m.current().name; got != "catalog" {
+			t.Errorf("path %q: expected root, got %q", path, got)
+		}
+		if m.notice != "" {
+			t.Errorf("path %q: unexpected notice %q", path, m.notice)
+		}
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,90 @@
+// Package dialog provides a dialog with Yes and No buttons.
+package dialog
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	yes = iota
+	no
+)
+
+var labels = []string{"Yes", "No"}
+
+var (
+	boxStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("62")).
+			Padding(1, 2)
+	focusedStyle = lipgloss.NewStyle().
+			Background(lipgloss.Color("205")).
+			Foreground(lipgloss.Color("0")).
+			Bold(true)
+	blurredStyle = lipgloss.NewStyle().
+			Background(lipgloss.Color("238")).
+			Foreground(lipgloss.Color("250"))
+)
+
+// Model is a question with Yes and No buttons. No, the cancel button, is
+// focused to begin with, so that pressing enter straight away is safe.
+// Embed it in a parent model, forward messages to Update, and check Chosen
+// after each update to see if the user answered.
+type Model struct {
+	question string
+	focus    int
+	choice   bool
+	answered bool
+}
+
+// New returns a dialog asking the given question.
+func New(question string) Model {
+	return Model{question: question, focus: no}
+}
+
+// Chosen returns the user's choice, and whether they have made one yet.
+func (m Model) Chosen() (bool, bool) {
+	return m.choice, m.answered
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if m.answered {
+		return m, nil
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "left", "h":
+			m.focus = max(0, m.focus-1)
+		case "right", "l":
+			m.focus = min(len(labels)-1, m.focus+1)
+		case "tab":
+			m.focus = (m.focus + 1) % len(labels)
+		case "shift+tab":
+			m.focus = (m.focus - 1 + len(labels)) % len(labels)
+		case "enter":
+			m.choice, m.answered = m.focus == yes, true
+		case "esc":
+			// Esc presses cancel, wherever the focus is.
+			m.focus = no
+			m.choice, m.answered = false, true
+		}
+	}
+	return m, nil
+}
+
+func (m Model) View() string {
+	buttons := make([]string, len(labels))
+	for i, label := range labels {
+		// The focused button is marked with brackets as well as color, so
+		// it stands out in terminals without color too.
+		if i == m.focus {
+			buttons[i] = focusedStyle.Render("[ " + label + " ]")
+		} else {
+			buttons[i] = blurredStyle.Render("  " + label + "  ")
+		}
+	}
+	row := lipgloss.JoinHorizontal(lipgloss.Top, buttons[0], "  ", buttons[1])
+	return boxStyle.Render(lipgloss.JoinVertical(lipgloss.Center, m.question, "", row))
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"unordered/dialog"
+)
+
+var cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+
+type model struct {
+	files    []string
+	cursor   int
+	dialog   dialog.Model
+	deleting bool
+	status   string
+}
+
+func newModel(files []string) model {
+	return model{files: files}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.deleting {
+		var cmd tea.Cmd
+		m.dialog, cmd = m.dialog.Update(msg)
+		if yes, ok := m.dialog.Chosen(); ok {
+			m.deleting = false
+			name := m.files[m.cursor]
+			if yes {
+				m.files = slices.Delete(m.files, m.cursor, m.cursor+1)
+				m.cursor = max(0, min(m.cursor, len(m.files)-1))
+				m.status = "deleted " + name
+			} else {
+				m.status = "kept " + name
+			}
+		}
+		return m, cmd
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = max(0, min(len(m.files)-1, m.cursor+1))
+		case "d":
+			if len(m.files) > 0 {
+				m.dialog = dialog.New(fmt.Sprintf("Delete %s?", m.files[m.cursor]))
+				m.deleting = true
+				m.status = ""
+			}
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.deleting {
+		return m.dialog.View() + "\n\n←/→/tab: choose • enter: press • esc: cancel\n"
+	}
+	var b strings.Builder
+	for i, f := range m.files {
+		if i == m.cursor {
+			b.WriteString(cursorStyle.Render("> "+f) + "\n")
+		} else {
+			b.WriteString("  " + f + "\n")
+		}
+	}
+	if len(m.files) == 0 {
+		b.WriteString("  no files\n")
+	}
+	b.WriteString("\n")
+	if m.status != "" {
+		b.WriteString(m.status + "\n")
+	}
+	b.WriteString("j/k: move • d: delete • q: quit\n")
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel([]string{"notes.txt", "report.pdf", "budget.xlsx", "photo.jpg"}))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+var files = []string{"a.txt", "b.txt", "c.txt"}
+
+func TestConfirm(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(slices.Clone(files)), teatest.WithInitialTermSize(80, 24))
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Type("d")
+
+	// No is focused to begin with.
+	waitForStrings(t, tm, "Delete b.txt?", "  Yes    [ No ]")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyLeft})
+
+	waitForStrings(t, tm, "[ Yes ]    No  ")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForStrings(t, tm, "deleted b.txt")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if want := []string{"a.txt", "c.txt"}; !slices.Equal(fm.files, want) {
+		t.Errorf("want files %v, got %v", want, fm.files)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	for _, keys := range [][]tea.KeyMsg{
+		// Enter on the default button.
+		{{Type: tea.KeyEnter}},
+		// Esc cancels even with Yes focused.
+		{{Type: tea.KeyLeft}, {Type: tea.KeyEsc}},
+		// Tab cycles from No round to Yes and back to No.
+		{{Type: tea.KeyTab}, {Type: tea.KeyTab}, {Type: tea.KeyEnter}},
+	} {
+		var names []string
+		for _, k := range keys {
+			names = append(names, k.String())
+		}
+		t.Run(strings.Join(names, "+"), func(t *testing.T) {
+			tm := teatest.NewTestModel(t, newModel(slices.Clone(files)), teatest.WithInitialTermSize(80, 24))
+
+			tm.Type("d")
+
+			waitForStrings(t, tm, "Delete a.txt?")
+
+			for _, k := range keys {
+				tm.Send(k)
+			}
+
+			waitForStrings(t, tm, "kept a.txt")
+
+			tm.Type("q")
+
+			fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+			if !slices.Equal(fm.files, files) {
+				t.Errorf("expected no files to be deleted, got %v", fm.files)
+			}
+		})
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	errorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	helpStyle  = lipgloss.NewStyle().Faint(true)
+)
+
+type item struct {
+	name  string
+	qty   int
+	price float64
+}
+
+// column describes how a field of an item is shown and edited.
+type column struct {
+	title string
+	width int
+	// allowed reports whether a rune may be typed into the column, so
+	// that, for example, letters are rejected as they're typed into a
+	// numeric column.
+	allowed func(r rune) bool
+	get     func(item) string
+	// set parses the edited value into the item, returning an error if it
+	// isn't valid.
+	set func(*item, string) error
+}
+
+func anyRune(rune) bool { return true }
+
+func isDigit(r rune) bool { return r >= '0' && r <= '9' }
+
+var columns = []column{
+	{
+		title: "Name", width: 16, allowed: anyRune,
+		get: func(it item) string { return it.name },
+		set: func(it *item, s string) error {
+			if strings.TrimSpace(s) == "" {
+				return errors.New("name can't be empty")
+			}
+			it.name = strings.TrimSpace(s)
+			return nil
+		},
+	},
+	{
+		title: "Qty", width: 6, allowed: isDigit,
+		get: func(it item) string { return strconv.Itoa(it.qty) },
+		set: func(it *item, s string) error {
+			n, err := strconv.Atoi(s)
+			if err != nil {
+				return errors.New("quantity must be a whole number")
+			}
+			it.qty = n
+			return nil
+		},
+	},
+	{
+		title: "Price", width: 10, allowed: func(r rune) bool { return isDigit(r) || r == '.' },
+		get: func(it item) string { return strconv.FormatFloat(it.price, 'f', 2, 64) },
+		set: func(it *item, s string) error {
+			f, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return errors.New("price must be a number, e.g. 4.99")
+			}
+			it.price = f
+			return nil
+		},
+	},
+}
+
+type model struct {
+	items []item
+	table table.Model
+	// col is the selected column; the table only tracks the row.
+	col int
+
+	editing bool
+	input   textinput.Model
+	err     error
+}
+
+func newModel(items []item) model {
+	cols := make([]table.Column, len(columns))
+	for i, c := range columns {
+		cols[i] = table.Column{Title: c.title, Width: c.width}
+	}
+	m := model{
+		items: items,
+		table: table.New(
+			table.WithColumns(cols),
+			table.WithFocused(true),
+			table.WithHeight(len(items)+1),
+		),
+		input: textinput.New(),
+	}
+	m.refresh()
+	return m
+}
+
+// refresh rebuilds the table's rows from the items. The selected cell is
+// marked, and while editing it shows the input instead. The input is
+// rendered as plain text, since the table truncates cells without regard
+// for escape sequences.
+func (m *model) refresh() {
+	rows := make([]table.Row, len(m.items))
+	for i, it := range m.items {
+		row := make(table.Row, len(columns))
+		for j, c := range columns {
+			row[j] = c.get(it)
+			if i != m.table.Cursor() || j != m.col {
+				continue
+			}
+			if m.editing {
+				value := []rune(m.input.Value())
+				pos := m.input.Position()
+				row[j] = string(value[:pos]) + "▏" + string(value[pos:])
+			} else {
+				row[j] = "▸" + row[j]
+			}
+		}
+		rows[i] = row
+	}
+	m.table.SetRows(rows)
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		if m.editing {
+			return m.updateEditing(msg)
+		}
+		switch msg.String() {
+		case "q":
+			return m, tea.Quit
+		case "left", "h":
+			m.col = max(0, m.col-1)
+		case "right", "l":
+			m.col = min(len(columns)-1, m.col+1)
+		case "enter":
+			if len(m.items) == 0 {
+				return m, nil
+			}
+			m.editing = true
+			m.input.SetValue(columns[m.col].get(m.items[m.table.Cursor()]))
+			m.input.CursorEnd()
+			m.refresh()
+			return m, m.input.Focus()
+		default:
+			var cmd tea.Cmd
+			m.table, cmd = m.table.Update(msg)
+			m.refresh()
+			return m, cmd
+		}
+		m.refresh()
+	}
+	return m, nil
+}
+
+func (m model) updateEditing(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.editing = false
+		m.err = nil
+		m.input.Blur()
+		m.refresh()
+		return m, nil
+	case "enter":
+		it := m.items[m.table.Cursor()]
+		if err := columns[m.col].set(&it, m.input.Value()); err != nil {
+			// Keep editing, so the value can be corrected.
+			m.err = err
+			return m, nil
+		}
+		m.items[m.table.Cursor()] = it
+		m.editing = false
+		m.err = nil
+		m.input.Blur()
+		m.refresh()
+		return m, nil
+	}
+	if msg.Type == tea.KeyRunes {
+		for _, r := range msg.Runes {
+			if !columns[m.col].allowed(r) {
+				m.err = fmt.Errorf("%q isn't allowed in %s", r, strings.ToLower(columns[m.col].title))
+				return m, nil
+			}
+		}
+	}
+	m.err = nil
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	m.refresh()
+	return m, cmd
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	b.WriteString(m.table.View() + "\n\n")
+	if m.err != nil {
+		b.WriteString(errorStyle.Render("✗ "+m.err.Error()) + "\n")
+	}
+	help := "↑/↓/←/→: move • enter: edit • q: quit"
+	if m.editing {
+		help = "enter: save • esc: cancel"
+	}
+	b.WriteString(helpStyle.Render(help) + "\n")
+	return b.String()
+}
+
+func main() {
+	items := []item{
+		{"Apples", 12, 0.45},
+		{"Bread", 1, 2.10},
+		{"Milk", 2, 1.15},
+		{"Coffee beans", 1, 7.99},
+	}
+	p := tea.NewProgram(newModel(items))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
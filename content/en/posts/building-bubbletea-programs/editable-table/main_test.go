@@ -0,0 +1,101 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func testItems() []item {
+	return []item{
+		{"Apples", 12, 0.45},
+		{"Bread", 1, 2.10},
+		{"Milk", 2, 1.15},
+	}
+}
+
+func TestEditLastCell(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(testItems()), teatest.WithInitialTermSize(80, 20))
+
+	// Move beyond the last row and column: the cursor stops at the edge.
+	for range 5 {
+		tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+		tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+	}
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "1.15▏")
+
+	for range 4 {
+		tm.Send(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	// The letter is rejected as it's typed.
+	tm.Type("3a")
+
+	waitForString(t, tm, `'a' isn't allowed in price`)
+
+	tm.Type(".5")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "▸3.50")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.items[2].price; got != 3.5 {
+		t.Errorf("expected price to be updated to 3.5, got %v", got)
+	}
+	if got := fm.table.Rows()[2][2]; got != "▸3.50" {
+		t.Errorf("unexpected cell: %q", got)
+	}
+}
+
+func TestEditInvalid(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(testItems()), teatest.WithInitialTermSize(80, 20))
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "12▏")
+
+	// Clearing the quantity leaves something that's not a number.
+	tm.Send(tea.KeyMsg{Type: tea.KeyBackspace})
+	tm.Send(tea.KeyMsg{Type: tea.KeyBackspace})
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "quantity must be a whole number")
+
+	// Still editing, so the value can be fixed.
+	tm.Type("7")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "▸7")
+
+	// Cancelling an edit leaves the value as it was.
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+	tm.Type("99")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.items[0].qty; got != 7 {
+		t.Errorf("expected quantity 7, got %d", got)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
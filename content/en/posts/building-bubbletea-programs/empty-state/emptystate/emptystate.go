@@ -0,0 +1,60 @@
+// Package emptystate provides a placeholder to show in place of an empty
+// list, explaining why there's nothing there and what to do about it.
+package emptystate
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	illustrationStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	messageStyle      = lipgloss.NewStyle().Bold(true)
+	errorStyle        = lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Bold(true)
+	hintStyle         = lipgloss.NewStyle().Faint(true)
+)
+
+// Model is centered in the space it's given, with an illustration above a
+// message and a hint at what to do next. Where there isn't room for the
+// illustration, it's left out.
+type Model struct {
+	illustration  string
+	message, hint string
+	err           error
+	errHint       string
+	width, height int
+}
+
+// New returns an empty state with the given illustration, message and
+// hint.
+func New(illustration, message, hint string) Model {
+	return Model{illustration: strings.Trim(illustration, "\n"), message: message, hint: hint}
+}
+
+// SetSize sets the size of the area the empty state is centered in.
+func (m *Model) SetSize(width, height int) {
+	m.width, m.height = width, height
+}
+
+// SetError shows the error instead of the message, with its own hint, until
+// it's cleared with a nil error. The list is empty for a different reason,
+// so the message and hint for an empty list no longer apply.
+func (m *Model) SetError(err error, hint string) {
+	m.err, m.errHint = err, hint
+}
+
+func (m Model) View() string {
+	message, hint := messageStyle.Render(m.message), hintStyle.Render(m.hint)
+	if m.err != nil {
+		message, hint = errorStyle.Render("✗ "+m.err.Error()), hintStyle.Render(m.errHint)
+	}
+	parts := []string{message, "", hint}
+	// Leave a blank line between the illustration and the message, and
+	// keep a line free above and below.
+	if m.err == nil && m.illustration != "" && lipgloss.Height(m.illustration)+len(parts)+3 <= m.height {
+		parts = append([]string{illustrationStyle.Render(m.illustration), ""}, parts...)
+	}
+	content := lipgloss.JoinVertical(lipgloss.Center, parts...)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"unordered/emptystate"
+)
+
+const clipboard = `
+ .-------.
+ | [===] |
+ | ----- |
+ | ----- |
+ | ---   |
+ '-------'
+`
+
+var (
+	cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	helpStyle   = lipgloss.NewStyle().Faint(true)
+)
+
+type model struct {
+	tasks  []string
+	cursor int
+	next   int
+	empty  emptystate.Model
+	err    error
+}
+
+func newModel() model {
+	return model{next: 1, empty: emptystate.New(clipboard, "No tasks yet", "press n to create one")}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "n":
+			m.tasks = append(m.tasks, fmt.Sprintf("task %d", m.next))
+			m.next++
+			m.cursor = len(m.tasks) - 1
+		case "d":
+			if len(m.tasks) > 0 {
+				m.tasks = slices.Delete(m.tasks, m.cursor, m.cursor+1)
+				m.cursor = max(0, min(m.cursor, len(m.tasks)-1))
+			}
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = max(0, min(len(m.tasks)-1, m.cursor+1))
+		case "e":
+			// Pretend that loading the tasks failed.
+			m.err = errors.New("could not load tasks: connection refused")
+			m.empty.SetError(m.err, "press r to retry")
+		case "r":
+			m.err = nil
+			m.empty.SetError(nil, "")
+		}
+	case tea.WindowSizeMsg:
+		// The help line takes up the last row.
+		m.empty.SetSize(msg.Width, msg.Height-1)
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	help := helpStyle.Render("n: new • d: delete • e: fail to load • r: retry • q: quit")
+	if m.err != nil || len(m.tasks) == 0 {
+		return m.empty.View() + "\n" + help
+	}
+	var b strings.Builder
+	for i, t := range m.tasks {
+		if i == m.cursor {
+			b.WriteString(cursorStyle.Render("> "+t) + "\n")
+		} else {
+			b.WriteString("  " + t + "\n")
+		}
+	}
+	return b.String() + "\n" + help
+}
+
+func main() {
+	p := tea.NewProgram(newModel(), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
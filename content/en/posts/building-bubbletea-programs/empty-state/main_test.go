@@ -0,0 +1,94 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+
+	"unordered/emptystate"
+)
+
+func TestEmptyToList(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(), teatest.WithInitialTermSize(60, 20))
+
+	waitForStrings(t, tm, "| [===] |", "No tasks yet", "press n to create one")
+
+	tm.Type("n")
+
+	waitForStrings(t, tm, "> task 1")
+
+	// Deleting the only task brings the empty state back.
+	tm.Type("d")
+
+	waitForStrings(t, tm, "No tasks yet")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if len(fm.tasks) != 0 {
+		t.Errorf("expected no tasks, got %v", fm.tasks)
+	}
+}
+
+func TestShortTerminal(t *testing.T) {
+	m := emptystate.New(clipboard, "No tasks yet", "press n to create one")
+
+	m.SetSize(40, 12)
+	if view := m.View(); !strings.Contains(view, "| [===] |") {
+		t.Errorf("expected the illustration to fit:\n%s", view)
+	}
+
+	// Too short for the illustration, but there's still room to say what
+	// to do.
+	m.SetSize(40, 5)
+	view := m.View()
+	if strings.Contains(view, "| [===] |") {
+		t.Errorf("expected the illustration to be hidden:\n%s", view)
+	}
+	if !strings.Contains(view, "No tasks yet") || !strings.Contains(view, "press n to create one") {
+		t.Errorf("expected the message and hint:\n%s", view)
+	}
+}
+
+func TestError(t *testing.T) {
+	m := emptystate.New(clipboard, "No tasks yet", "press n to create one")
+	m.SetSize(60, 20)
+	m.SetError(errors.New("connection refused"), "press r to retry")
+
+	view := m.View()
+	for _, want := range []string{"✗ connection refused", "press r to retry"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in error state:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "No tasks yet") {
+		t.Errorf("expected the empty message to be replaced:\n%s", view)
+	}
+
+	m.SetError(nil, "")
+	if view := m.View(); !strings.Contains(view, "No tasks yet") {
+		t.Errorf("expected the empty message once the error is cleared:\n%s", view)
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
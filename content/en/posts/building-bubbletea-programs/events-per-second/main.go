@@ -0,0 +1,148 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// buckets is the number of intervals the one second window is split
+	// into.
+	buckets = 10
+	// history is the number of EPS samples shown in the sparkline.
+	history = 40
+)
+
+var (
+	epsStyle   = lipgloss.NewStyle().Bold(true)
+	sparkStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("39"))
+	sparks     = []rune("▁▂▃▄▅▆▇█")
+)
+
+type (
+	eventMsg struct{}
+	tickMsg  struct{}
+)
+
+type model struct {
+	events   <-chan struct{}
+	interval time.Duration
+
+	// counts is the number of events in each interval of the last second,
+	// used as a ring buffer. Counting rather than recording each event
+	// means a burst of any size takes no more memory.
+	counts  [buckets]int
+	current int
+	total   int
+	samples []int
+}
+
+func newModel(events <-chan struct{}, interval time.Duration) model {
+	return model{events: events, interval: interval}
+}
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(waitForEvent(m.events), m.tick())
+}
+
+func waitForEvent(events <-chan struct{}) tea.Cmd {
+	return func() tea.Msg {
+		<-events
+		return eventMsg{}
+	}
+}
+
+func (m model) tick() tea.Cmd {
+	return tea.Tick(m.interval, func(time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
+
+// eps returns the number of events in the last second.
+func (m model) eps() int {
+	var sum int
+	for _, n := range m.counts {
+		sum += n
+	}
+	return sum
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg.(type) {
+	case tea.KeyMsg:
+		return m, tea.Quit
+	case eventMsg:
+		m.counts[m.current]++
+		m.total++
+		return m, waitForEvent(m.events)
+	case tickMsg:
+		m.samples = append(m.samples, m.eps())
+		if len(m.samples) > history {
+			m.samples = m.samples[1:]
+		}
+		// Slide the window along, dropping the oldest interval. The rate
+		// falls an interval at a time rather than all at once when events
+		// stop.
+		m.current = (m.current + 1) % buckets
+		m.counts[m.current] = 0
+		return m, m.tick()
+	}
+	return m, nil
+}
+
+// sparkline renders the samples scaled to the largest, with zero left
+// blank so that it's distinct from a low rate.
+func sparkline(samples []int) string {
+	peak := 0
+	for _, s := range samples {
+		peak = max(peak, s)
+	}
+	var b strings.Builder
+	for _, s := range samples {
+		if s == 0 {
+			b.WriteRune(' ')
+			continue
+		}
+		b.WriteRune(sparks[(s*(len(sparks)-1)+peak-1)/peak])
+	}
+	return b.String()
+}
+
+func (m model) View() string {
+	return fmt.Sprintf("events/s: %s\ntotal:    %d\n\n%s\n\npress any key to quit\n",
+		epsStyle.Render(fmt.Sprint(m.eps())),
+		m.total,
+		sparkStyle.Render(fmt.Sprintf("%-*s", history, sparkline(m.samples))),
+	)
+}
+
+// produce sends events at a rate that rises and falls, with the occasional
+// burst.
+func produce(events chan<- struct{}) {
+	for i := 0; ; i++ {
+		rate := 20 + 15*(i/50%4)
+		if rand.Intn(200) == 0 {
+			for range 1000 {
+				events <- struct{}{}
+			}
+		}
+		events <- struct{}{}
+		time.Sleep(time.Second / time.Duration(rate))
+	}
+}
+
+func main() {
+	events := make(chan struct{})
+	go produce(events)
+	p := tea.NewProgram(newModel(events, time.Second/buckets))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
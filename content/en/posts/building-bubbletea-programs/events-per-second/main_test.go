@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestRiseAndDecay(t *testing.T) {
+	events := make(chan struct{})
+	// Tick only when the test says so.
+	m := newModel(events, time.Hour)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 20))
+
+	// 100 events in each of the first 5 intervals.
+	for i := range 5 {
+		for range 100 {
+			events <- struct{}{}
+		}
+		waitForString(t, tm, fmt.Sprintf("total:    %d", (i+1)*100))
+		tm.Send(tickMsg{})
+	}
+	// Then nothing, for long enough for the window to slide past all of
+	// them.
+	for range buckets {
+		tm.Send(tickMsg{})
+	}
+
+	waitForString(t, tm, "events/s: 0")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	// The rate rises while events arrive, and holds until the first of
+	// them fall out of the window, when it falls one interval at a time.
+	want := []int{100, 200, 300, 400, 500, 500, 500, 500, 500, 500, 400, 300, 200, 100, 0}
+	if fmt.Sprint(fm.samples) != fmt.Sprint(want) {
+		t.Errorf("want samples %v, got %v", want, fm.samples)
+	}
+	if got := sparkline(fm.samples); got != "▃▄▆▇██████▇▆▄▃ " {
+		t.Errorf("unexpected sparkline: %q", got)
+	}
+}
+
+func TestBurst(t *testing.T) {
+	events := make(chan struct{}, 10000)
+	m := newModel(events, time.Hour)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 20))
+
+	// Far more events in one interval than in the rest of the window.
+	for range 10000 {
+		events <- struct{}{}
+	}
+
+	waitForStrings(t, tm, "events/s: 10000", "total:    10000")
+
+	for range buckets {
+		tm.Send(tickMsg{})
+	}
+
+	waitForString(t, tm, "events/s: 0")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	waitForStrings(t, tm, s)
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	successStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+	failureStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+)
+
+type (
+	// startedMsg is sent once the process has started, with a func to
+	// kill it.
+	startedMsg struct{ kill func() }
+	lineMsg    string
+	exitMsg    struct {
+		code int
+		err  error
+	}
+)
+
+type model struct {
+	args   []string
+	output chan tea.Msg
+	kill   func()
+
+	lines    []string
+	viewport viewport.Model
+	ready    bool
+	exited   *exitMsg
+	quitting bool
+}
+
+func newModel(args ...string) model {
+	return model{args: args, output: make(chan tea.Msg)}
+}
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(run(m.args, m.output), waitForOutput(m.output))
+}
+
+// run starts the process, sending a startedMsg to the channel, then each
+// line of its combined stdout and stderr, then its exit status, before
+// closing the channel. Sending everything on the one channel ensures the
+// exit status arrives after the last line.
+func run(args []string, output chan<- tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		defer close(output)
+
+		cmd := exec.Command(args[0], args[1:]...)
+		pr, pw := io.Pipe()
+		cmd.Stdout = pw
+		cmd.Stderr = pw
+		// Don't wait forever for the output of any children the process
+		// leaves behind after it's killed.
+		cmd.WaitDelay = time.Second
+		if err := cmd.Start(); err != nil {
+			output <- exitMsg{code: -1, err: err}
+			return nil
+		}
+		output <- startedMsg{kill: func() { cmd.Process.Kill() }}
+
+		scanned := make(chan struct{})
+		go func() {
+			defer close(scanned)
+			scanner := bufio.NewScanner(pr)
+			for scanner.Scan() {
+				output <- lineMsg(scanner.Text())
+			}
+			// Keep draining if the scanner gives up, e.g. on a very long
+			// line, otherwise the process blocks writing its output.
+			io.Copy(io.Discard, pr)
+		}()
+
+		err := cmd.Wait()
+		pw.Close()
+		<-scanned
+		output <- exitMsg{code: cmd.ProcessState.ExitCode(), err: err}
+		return nil
+	}
+}
+
+func waitForOutput(output <-chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		msg, ok := <-output
+		if !ok {
+			return nil
+		}
+		return msg
+	}
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			if m.exited != nil {
+				return m, tea.Quit
+			}
+			// Kill the process, and quit once it has exited.
+			m.quitting = true
+			if m.kill != nil {
+				m.kill()
+			}
+			return m, nil
+		case "q":
+			if m.exited != nil {
+				return m, tea.Quit
+			}
+		}
+	case tea.WindowSizeMsg:
+		if !m.ready {
+			m.viewport = viewport.New(msg.Width, msg.Height-2)
+			m.ready = true
+		} else {
+			m.viewport.Width = msg.Width
+			m.viewport.Height = msg.Height - 2
+		}
+		m.viewport.SetContent(strings.Join(m.lines, "\n"))
+		m.viewport.GotoBottom()
+		return m, nil
+	case startedMsg:
+		m.kill = msg.kill
+		if m.quitting {
+			m.kill()
+		}
+		return m, waitForOutput(m.output)
+	case lineMsg:
+		m.lines = append(m.lines, string(msg))
+		atBottom := m.viewport.AtBottom()
+		m.viewport.SetContent(strings.Join(m.lines, "\n"))
+		if atBottom {
+			m.viewport.GotoBottom()
+		}
+		return m, waitForOutput(m.output)
+	case exitMsg:
+		m.exited = &msg
+		if m.quitting {
+			return m, tea.Quit
+		}
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+func (m model) View() string {
+	if !m.ready {
+		return "starting..."
+	}
+	var status string
+	switch {
+	case m.exited == nil && m.quitting:
+		status = "killing " + m.args[0] + "..."
+	case m.exited == nil:
+		status = "running " + strings.Join(m.args, " ") + " • ctrl+c: kill"
+	case m.exited.code == 0:
+		status = successStyle.Render("exit code 0") + " • q: quit"
+	case m.exited.code == -1:
+		status = failureStyle.Render(m.exited.err.Error()) + " • q: quit"
+	default:
+		status = failureStyle.Render(fmt.Sprintf("exit code %d", m.exited.code)) + " • q: quit"
+	}
+	return m.viewport.View() + "\n\n" + status
+}
+
+func main() {
+	args := os.Args[1:]
+	if len(args) == 0 {
+		args = []string{"sh", "-c", "for i in 1 2 3 4 5; do echo line $i; sleep 0.5; done; echo oops >&2; exit 1"}
+	}
+	p := tea.NewProgram(newModel(args...), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestExec(t *testing.T) {
+	m := newModel("sh", "-c", "echo hello; echo oops >&2; exit 3")
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForStrings(t, tm, "hello", "oops", "exit code 3")
+
+	tm.Type("q")
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestKill(t *testing.T) {
+	m := newModel("sleep", "60")
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "running sleep 60")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(5*time.Second)).(model)
+	if fm.exited == nil || fm.exited.code != -1 {
+		t.Errorf("expected process to have been killed, got %+v", fm.exited)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	waitForStrings(t, tm, s)
+}
+
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// defaultEditor is used when $EDITOR is unset.
+const defaultEditor = "vi"
+
+var (
+	contentStyle = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+	errorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+)
+
+type editorFinishedMsg struct {
+	path string
+	err  error
+}
+
+type model struct {
+	editor  string
+	content string
+	status  string
+}
+
+func newModel(editor string) model {
+	return model{editor: editor, content: "Edit me!"}
+}
+
+// editorFromEnv returns the user's preferred editor.
+func editorFromEnv() string {
+	if editor := os.Getenv("EDITOR"); editor != "" {
+		return editor
+	}
+	return defaultEditor
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+// openEditor writes the content to a temp file and opens it in the editor.
+// The program is suspended until the editor exits.
+func (m model) openEditor() tea.Cmd {
+	f, err := os.CreateTemp("", "external-editor-*.txt")
+	if err != nil {
+		return func() tea.Msg { return editorFinishedMsg{err: err} }
+	}
+	defer f.Close()
+	if _, err := f.WriteString(m.content); err != nil {
+		return func() tea.Msg { return editorFinishedMsg{path: f.Name(), err: err} }
+	}
+	// $EDITOR may include arguments, e.g. "code --wait".
+	args := append(strings.Fields(m.editor), f.Name())
+	cmd := exec.Command(args[0], args[1:]...)
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return editorFinishedMsg{path: f.Name(), err: err}
+	})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "e":
+			m.status = ""
+			return m, m.openEditor()
+		}
+	case editorFinishedMsg:
+		if msg.path != "" {
+			defer os.Remove(msg.path)
+		}
+		if msg.err != nil {
+			// The editor failed, or the user told it to abort, e.g. :cq
+			// in vim, so don't trust whatever is in the file.
+			m.status = errorStyle.Render("editor failed, changes discarded: " + msg.err.Error())
+			return m, nil
+		}
+		b, err := os.ReadFile(msg.path)
+		if err != nil {
+			m.status = errorStyle.Render("reading edited file: " + err.Error())
+			return m, nil
+		}
+		m.content = strings.TrimRight(string(b), "\n")
+		m.status = "changes loaded"
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	s := contentStyle.Render(m.content) + "\n\n"
+	if m.status != "" {
+		s += m.status + "\n\n"
+	}
+	return s + "e: edit in " + m.editor + " • q: quit\n"
+}
+
+func main() {
+	p := tea.NewProgram(newModel(editorFromEnv()))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+// fakeEditor writes a script that stands in for the editor, overwriting the
+// file it's given and exiting with the given code.
+func fakeEditor(t *testing.T, content string, code int) string {
+	path := filepath.Join(t.TempDir(), "editor.sh")
+	script := fmt.Sprintf("#!/bin/sh\nprintf '%%s' '%s' > \"$1\"\nexit %d\n", content, code)
+	if err := os.WriteFile(path, []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestEdit(t *testing.T) {
+	m := newModel(fakeEditor(t, "edited content", 0))
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "Edit me!")
+
+	tm.Type("e")
+
+	waitForString(t, tm, "changes loaded")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	if fm.content != "edited content" {
+		t.Errorf("expected edited content to be loaded, got %q", fm.content)
+	}
+}
+
+func TestEditorFails(t *testing.T) {
+	m := newModel(fakeEditor(t, "edited content", 1))
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	tm.Type("e")
+
+	waitForString(t, tm, "editor failed, changes discarded")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	if fm.content != "Edit me!" {
+		t.Errorf("expected content to be unchanged, got %q", fm.content)
+	}
+}
+
+func TestEditorFromEnv(t *testing.T) {
+	t.Setenv("EDITOR", "")
+	if got := editorFromEnv(); got != defaultEditor {
+		t.Errorf("expected %q when $EDITOR is unset, got %q", defaultEditor, got)
+	}
+	t.Setenv("EDITOR", "nano")
+	if got := editorFromEnv(); got != "nano" {
+		t.Errorf("expected nano, got %q", got)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	helpStyle   = lipgloss.NewStyle().Faint(true)
+)
+
+type faq struct {
+	question string
+	// answer is in markdown.
+	answer string
+}
+
+// renderedMsg holds the answers rendered for the width.
+type renderedMsg struct {
+	width   int
+	answers []string
+	err     error
+}
+
+type model struct {
+	faqs []faq
+	// expanded is keyed by the index into faqs, rather than into the
+	// visible questions, so that it survives filtering.
+	expanded map[int]bool
+	// rendered holds each answer rendered for the current width, or nil
+	// until they have been.
+	rendered []string
+	width    int
+	// cursor is an index into the visible questions.
+	cursor int
+
+	filter    textinput.Model
+	filtering bool
+
+	// err is set if the answers couldn't be rendered, and is reported once
+	// the program has exited.
+	err error
+}
+
+func newModel(faqs []faq) model {
+	filter := textinput.New()
+	filter.Prompt = "/"
+	return model{faqs: faqs, expanded: make(map[int]bool), filter: filter}
+}
+
+// visible returns the indices of the questions matching the filter. An
+// expanded question that doesn't match is hidden along with its answer, but
+// stays expanded for when it matches again.
+func (m model) visible() []int {
+	query := strings.ToLower(m.filter.Value())
+	var indices []int
+	for i, f := range m.faqs {
+		if strings.Contains(strings.ToLower(f.question), query) {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// render returns a command that renders every answer for the given width.
+// Answers are rendered up front, rather than in View, and in the
+// background, rather than in Update, because glamour is too slow to run on
+// every frame.
+func render(faqs []faq, width int) tea.Cmd {
+	return func() tea.Msg {
+		r, err := glamour.NewTermRenderer(
+			glamour.WithStandardStyle("dark"),
+			glamour.WithWordWrap(width),
+		)
+		if err != nil {
+			return renderedMsg{width: width, err: err}
+		}
+		answers := make([]string, len(faqs))
+		for i, f := range faqs {
+			out, err := r.Render(f.answer)
+			if err != nil {
+				return renderedMsg{width: width, err: err}
+			}
+			answers[i] = strings.Trim(out, "\n")
+		}
+		return renderedMsg{width: width, answers: answers}
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.filtering {
+			return m.updateFilter(msg)
+		}
+		visible := m.visible()
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = max(0, min(len(visible)-1, m.cursor+1))
+		case "enter", " ":
+			if m.cursor < len(visible) {
+				idx := visible[m.cursor]
+				m.expanded[idx] = !m.expanded[idx]
+			}
+		case "/":
+			m.filtering = true
+			return m, m.filter.Focus()
+		case "esc":
+			m.filter.SetValue("")
+			m.cursor = 0
+		}
+	case tea.WindowSizeMsg:
+		// Until the answers have been rendered for the new width, show
+		// only the questions.
+		m.width, m.rendered = msg.Width, nil
+		return m, render(m.faqs, msg.Width)
+	case renderedMsg:
+		if msg.width != m.width {
+			// The terminal has been resized since.
+			return m, nil
+		}
+		if msg.err != nil {
+			m.err = msg.err
+			return m, tea.Quit
+		}
+		m.rendered = msg.answers
+	}
+	return m, nil
+}
+
+func (m model) updateFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		m.filter.SetValue("")
+		fallthrough
+	case "enter":
+		m.filtering = false
+		m.filter.Blur()
+		m.cursor = 0
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.filter, cmd = m.filter.Update(msg)
+	m.cursor = 0
+	return m, cmd
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	visible := m.visible()
+	if len(visible) == 0 {
+		b.WriteString(helpStyle.Render(fmt.Sprintf("no questions match %q", m.filter.Value())) + "\n")
+	}
+	for i, idx := range visible {
+		marker := "▸ "
+		if m.expanded[idx] {
+			marker = "▾ "
+		}
+		question := marker + m.faqs[idx].question
+		if i == m.cursor {
+			question = cursorStyle.Render(question)
+		}
+		b.WriteString(question + "\n")
+		if m.expanded[idx] && m.rendered != nil {
+			// The style's margin indents the answer beneath its question.
+			b.WriteString(m.rendered[idx] + "\n")
+		}
+	}
+	b.WriteString("\n")
+	if m.filtering || m.filter.Value() != "" {
+		b.WriteString(m.filter.View() + "\n")
+	}
+	b.WriteString(helpStyle.Render("j/k: select • enter: expand/collapse • /: filter • esc: clear filter • q: quit") + "\n")
+	return b.String()
+}
+
+var faqs = []faq{
+	{
+		question: "How do I install it?",
+		answer:   "Run `go install example.com/tool@latest`, then make sure `$GOPATH/bin` is on your **PATH**.",
+	},
+	{
+		question: "Where is the config file?",
+		answer:   "It's read from `~/.config/tool/config.yaml`. Settings given as flags take precedence.",
+	},
+	{
+		question: "Can I use it offline?",
+		answer:   "Yes. Everything except `tool sync` works without a network connection:\n\n- browsing\n- searching\n- editing",
+	},
+	{
+		question: "How do I report a bug?",
+		answer:   "Open an issue with the output of `tool version` and the steps to reproduce it.",
+	},
+}
+
+func main() {
+	p := tea.NewProgram(newModel(faqs), tea.WithAltScreen())
+	m, err := p.Run()
+	if err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+	if err := m.(model).err; err != nil {
+		fmt.Println("could not render answers:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestExpandThenFilter(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(faqs), teatest.WithInitialTermSize(80, 30))
+
+	waitForStrings(t, tm, "▸ How do I install it?")
+
+	// Expand the third question.
+	tm.Type("jj")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForStrings(t, tm, "▾ Can I use it offline?", "without", "browsing")
+
+	tm.Type("/offline")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForStrings(t, tm, "/offline")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	view := fm.View()
+	if !strings.Contains(view, "▾ Can I use it offline?") || !strings.Contains(view, "browsing") {
+		t.Errorf("expected question to remain expanded:\n%s", view)
+	}
+	if strings.Contains(view, "How do I install it?") {
+		t.Errorf("expected other questions to be filtered out:\n%s", view)
+	}
+}
+
+func TestFilterOutExpanded(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(faqs), teatest.WithInitialTermSize(80, 30))
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForStrings(t, tm, "▾ How do I install it?")
+
+	// Filter the expanded question out, and then back in again.
+	tm.Type("/config")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForStrings(t, tm, "▸ Where is the config file?")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEscape})
+
+	waitForStrings(t, tm, "▾ How do I install it?")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if !fm.expanded[0] {
+		t.Error("expected first question to still be expanded")
+	}
+}
+
+func TestNoMatches(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(faqs), teatest.WithInitialTermSize(80, 30))
+
+	tm.Type("/zebra")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForStrings(t, tm, `no questions match "zebra"`)
+
+	// Nothing to expand.
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if len(fm.expanded) != 0 {
+		t.Errorf("expected nothing expanded, got %v", fm.expanded)
+	}
+}
+
+func TestStaleRender(t *testing.T) {
+	var m tea.Model = newModel(faqs)
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 80, Height: 30})
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 60, Height: 30})
+
+	// The answers rendered for the old width arrive after the resize.
+	m, _ = m.Update(renderedMsg{width: 80, answers: []string{"a", "b", "c", "d"}})
+	if got := m.(model).rendered; got != nil {
+		t.Errorf("expected stale answers to be dropped, got %v", got)
+	}
+
+	m, _ = m.Update(renderedMsg{width: 60, answers: []string{"a", "b", "c", "d"}})
+	if got := m.(model).rendered; len(got) != 4 {
+		t.Errorf("expected answers for the current width, got %v", got)
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	statusStyle = lipgloss.NewStyle().Faint(true)
+)
+
+type item struct {
+	id   int
+	name string
+}
+
+type model struct {
+	items []item
+	// selected is keyed by id rather than by position, which changes as
+	// the filter changes.
+	selected map[int]bool
+	// visible holds the indices of the items matching the filter.
+	visible   []int
+	cursor    int
+	filter    textinput.Model
+	filtering bool
+}
+
+func newModel(items []item) model {
+	filter := textinput.New()
+	filter.Prompt = "/"
+	m := model{items: items, selected: make(map[int]bool), filter: filter}
+	m.applyFilter()
+	return m
+}
+
+// applyFilter recomputes the visible items, keeping the cursor on the same
+// item if it's still visible.
+func (m *model) applyFilter() {
+	current := -1
+	if m.cursor < len(m.visible) {
+		current = m.items[m.visible[m.cursor]].id
+	}
+	query := strings.ToLower(m.filter.Value())
+	m.visible = nil
+	m.cursor = 0
+	for i, it := range m.items {
+		if !strings.Contains(strings.ToLower(it.name), query) {
+			continue
+		}
+		if it.id == current {
+			m.cursor = len(m.visible)
+		}
+		m.visible = append(m.visible, i)
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		if m.filtering {
+			return m.updateFilter(msg)
+		}
+		switch msg.String() {
+		case "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = max(0, min(len(m.visible)-1, m.cursor+1))
+		case " ", "x":
+			if len(m.visible) > 0 {
+				id := m.items[m.visible[m.cursor]].id
+				if m.selected[id] {
+					delete(m.selected, id)
+				} else {
+					m.selected[id] = true
+				}
+			}
+		case "c":
+			// Only clear the visible items: the user can't see the hidden
+			// ones, so shouldn't be surprised by losing them.
+			for _, i := range m.visible {
+				delete(m.selected, m.items[i].id)
+			}
+		case "/":
+			m.filtering = true
+			return m, m.filter.Focus()
+		case "esc":
+			m.filter.SetValue("")
+			m.applyFilter()
+		}
+	}
+	return m, nil
+}
+
+func (m model) updateFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		m.filtering = false
+		m.filter.Blur()
+		return m, nil
+	case "esc":
+		m.filtering = false
+		m.filter.Blur()
+		m.filter.SetValue("")
+		m.applyFilter()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.filter, cmd = m.filter.Update(msg)
+	m.applyFilter()
+	return m, cmd
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	if m.filtering || m.filter.Value() != "" {
+		b.WriteString(m.filter.View() + "\n\n")
+	}
+	for i, idx := range m.visible {
+		it := m.items[idx]
+		check := "[ ]"
+		if m.selected[it.id] {
+			check = "[x]"
+		}
+		line := check + " " + it.name
+		if i == m.cursor {
+			b.WriteString(cursorStyle.Render("> " + line))
+		} else {
+			b.WriteString("  " + line)
+		}
+		b.WriteString("\n")
+	}
+	if len(m.visible) == 0 {
+		b.WriteString("  no matches\n")
+	}
+
+	hidden := len(m.selected)
+	for _, i := range m.visible {
+		if m.selected[m.items[i].id] {
+			hidden--
+		}
+	}
+	status := fmt.Sprintf("%d selected", len(m.selected))
+	if hidden > 0 {
+		status += fmt.Sprintf(" (%d hidden)", hidden)
+	}
+	b.WriteString("\n" + statusStyle.Render(status+" • space: select • c: clear • /: filter • esc: clear filter • q: quit") + "\n")
+	return b.String()
+}
+
+func main() {
+	names := []string{"apple", "apricot", "banana", "blackberry", "cherry", "grape", "grapefruit", "lemon", "lime", "mango"}
+	items := make([]item, len(names))
+	for i, name := range names {
+		items[i] = item{id: i + 1, name: name}
+	}
+	p := tea.NewProgram(newModel(items))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+var fruit = []item{
+	{id: 1, name: "apple"},
+	{id: 2, name: "banana"},
+	{id: 3, name: "cherry"},
+	{id: 4, name: "apricot"},
+}
+
+func TestPreserveSelection(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(fruit), teatest.WithInitialTermSize(80, 24))
+
+	// Select banana.
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+
+	waitForString(t, tm, "> [x] banana")
+
+	// Filter banana out: it stays selected, just hidden.
+	tm.Type("/ap")
+
+	waitForString(t, tm, "1 selected (1 hidden)")
+
+	// Clear the filter.
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+
+	waitForString(t, tm, "[x] banana")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	fm := tm.FinalModel(t).(model)
+	if !fm.selected[2] {
+		t.Error("expected banana to still be selected")
+	}
+}
+
+func TestClearWhileFiltered(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(fruit), teatest.WithInitialTermSize(80, 24))
+
+	// Select apple and banana.
+	tm.Send(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+
+	waitForString(t, tm, "2 selected")
+
+	// Clearing while filtered only clears the visible apple.
+	tm.Type("/ap")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+	tm.Type("c")
+
+	waitForString(t, tm, "1 selected (1 hidden)")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	fm := tm.FinalModel(t).(model)
+	if fm.selected[1] {
+		t.Error("expected apple to be cleared")
+	}
+	if !fm.selected[2] {
+		t.Error("expected hidden banana to still be selected")
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
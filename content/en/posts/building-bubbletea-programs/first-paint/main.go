@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var overlayStyle = lipgloss.NewStyle().
+	Border(lipgloss.RoundedBorder()).
+	BorderForeground(lipgloss.Color("240")).
+	Padding(0, 1)
+
+type loadedMsg struct{ items []string }
+
+type model struct {
+	// now returns the current time. Tests replace it with a fake clock.
+	now  func() time.Time
+	load func() []string
+
+	// started is when the model was created, immediately before the program
+	// calls Init.
+	started time.Time
+	// painted is when the first tea.WindowSizeMsg arrived, which is
+	// when there is first something worth rendering.
+	painted time.Time
+	loaded  time.Time
+
+	width, height int
+	items         []string
+	showTimings   bool
+}
+
+func newModel(now func() time.Time, load func() []string) model {
+	return model{now: now, load: load, started: now()}
+}
+
+func (m model) Init() tea.Cmd {
+	load := m.load
+	return func() tea.Msg {
+		return loadedMsg{items: load()}
+	}
+}
+
+// timings returns the durations since the start until the first paint, the
+// data loading, and the data being visible on screen.
+func (m model) timings() (paint, load, visible time.Duration, ok bool) {
+	if m.painted.IsZero() || m.loaded.IsZero() {
+		return 0, 0, 0, false
+	}
+	paint = m.painted.Sub(m.started)
+	load = m.loaded.Sub(m.started)
+	// If the data loaded before the first paint, it isn't visible until
+	// the first paint.
+	visible = max(paint, load)
+	return paint, load, visible, true
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "t":
+			m.showTimings = !m.showTimings
+		}
+	case tea.WindowSizeMsg:
+		if m.painted.IsZero() {
+			m.painted = m.now()
+		}
+		m.width, m.height = msg.Width, msg.Height
+	case loadedMsg:
+		m.loaded = m.now()
+		m.items = msg.items
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.width == 0 {
+		return ""
+	}
+	var b strings.Builder
+	if m.loaded.IsZero() {
+		b.WriteString("loading...\n")
+	}
+	for _, item := range m.items {
+		b.WriteString("• " + item + "\n")
+	}
+	b.WriteString("\nt: toggle timings • q: quit\n")
+	if !m.showTimings {
+		return b.String()
+	}
+
+	timings := "waiting..."
+	if paint, load, visible, ok := m.timings(); ok {
+		timings = fmt.Sprintf("first paint  %6s\ndata loaded  %6s\ndata visible %6s",
+			paint.Round(time.Millisecond), load.Round(time.Millisecond), visible.Round(time.Millisecond))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, overlayStyle.Render("startup timings\n\n"+timings), b.String())
+}
+
+func load() []string {
+	time.Sleep(250 * time.Millisecond)
+	return []string{"alpha", "bravo", "charlie"}
+}
+
+func main() {
+	p := tea.NewProgram(newModel(time.Now, load))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
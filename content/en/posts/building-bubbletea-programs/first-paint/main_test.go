@@ -0,0 +1,119 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+// fakeClock is a clock that only moves when told to.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestTimings(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 8, 25, 12, 0, 0, 0, time.UTC)}
+	release := make(chan struct{})
+	load := func() []string {
+		<-release
+		return []string{"alpha"}
+	}
+	// No initial size, so the test decides when the first paint happens.
+	tm := teatest.NewTestModel(t, newModel(clock.Now, load))
+
+	clock.Advance(5 * time.Millisecond)
+	tm.Send(tea.WindowSizeMsg{Width: 80, Height: 24})
+	tm.Type("t")
+
+	waitForString(t, tm, "waiting...")
+
+	clock.Advance(20 * time.Millisecond)
+	close(release)
+
+	waitForStrings(t, tm, "first paint     5ms", "data loaded    25ms", "data visible   25ms")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	assertOrdered(t, fm)
+}
+
+func TestLoadBeforeFirstPaint(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 8, 25, 12, 0, 0, 0, time.UTC)}
+	loaded := make(chan struct{})
+	load := func() []string {
+		defer close(loaded)
+		return []string{"alpha"}
+	}
+	tm := teatest.NewTestModel(t, newModel(clock.Now, load))
+
+	// Give the program time to handle the loaded message before the size
+	// arrives.
+	<-loaded
+	time.Sleep(100 * time.Millisecond)
+
+	clock.Advance(10 * time.Millisecond)
+	tm.Send(tea.WindowSizeMsg{Width: 80, Height: 24})
+	tm.Type("t")
+
+	// The data isn't visible until the first paint.
+	waitForStrings(t, tm, "first paint    10ms", "data loaded      0s", "data visible   10ms")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	assertOrdered(t, fm)
+}
+
+func assertOrdered(t *testing.T, m model) {
+	t.Helper()
+
+	paint, load, visible, ok := m.timings()
+	if !ok {
+		t.Fatal("expected timings to be recorded")
+	}
+	if paint < 0 || load < 0 {
+		t.Errorf("expected non-negative timings, got paint=%s load=%s", paint, load)
+	}
+	if visible < paint || visible < load {
+		t.Errorf("expected data to be visible after both the paint and the load, got paint=%s load=%s visible=%s", paint, load, visible)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	waitForStrings(t, tm, s)
+}
+
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
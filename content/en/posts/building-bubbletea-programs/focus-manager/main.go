@@ -0,0 +1,208 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	panelStyle   = lipgloss.NewStyle().Width(16).Border(lipgloss.RoundedBorder()).Padding(0, 1)
+	focusedStyle = panelStyle.BorderForeground(lipgloss.Color("205"))
+	helpStyle    = lipgloss.NewStyle().Faint(true)
+)
+
+// Focusable is anything that can be given focus.
+type Focusable interface {
+	Focus()
+	Blur()
+}
+
+// keyHandler is a Focusable that handles keys while it has focus.
+type keyHandler interface {
+	HandleKey(tea.KeyMsg) tea.Cmd
+}
+
+// FocusManager gives focus to one of an ordered list of components at a
+// time, and routes keys to it.
+type FocusManager struct {
+	items []Focusable
+	// focused is the index of the focused component, or -1 if there are
+	// none.
+	focused int
+}
+
+func NewFocusManager(items ...Focusable) *FocusManager {
+	fm := &FocusManager{focused: -1}
+	for _, f := range items {
+		fm.Add(f)
+	}
+	return fm
+}
+
+// Add appends a component, focusing it if it's the first.
+func (fm *FocusManager) Add(f Focusable) {
+	fm.items = append(fm.items, f)
+	if fm.focused < 0 {
+		fm.focus(0)
+	} else {
+		f.Blur()
+	}
+}
+
+// Remove removes a component. If it had focus then the next component,
+// or the last if it was the last, is focused instead.
+func (fm *FocusManager) Remove(f Focusable) {
+	for i, item := range fm.items {
+		if item != f {
+			continue
+		}
+		f.Blur()
+		fm.items = append(fm.items[:i], fm.items[i+1:]...)
+		switch {
+		case len(fm.items) == 0:
+			fm.focused = -1
+		case i == fm.focused:
+			fm.focus(min(i, len(fm.items)-1))
+		case i < fm.focused:
+			fm.focused--
+		}
+		return
+	}
+}
+
+// Focused returns the focused component, or nil if there are none.
+func (fm *FocusManager) Focused() Focusable {
+	if fm.focused < 0 {
+		return nil
+	}
+	return fm.items[fm.focused]
+}
+
+func (fm *FocusManager) focus(i int) {
+	if fm.focused >= 0 && fm.focused < len(fm.items) {
+		fm.items[fm.focused].Blur()
+	}
+	fm.focused = i
+	fm.items[i].Focus()
+}
+
+// cycle moves focus forward or backward, wrapping around at either end.
+func (fm *FocusManager) cycle(delta int) {
+	if len(fm.items) == 0 {
+		return
+	}
+	fm.focus((fm.focused + delta + len(fm.items)) % len(fm.items))
+}
+
+// Update cycles focus on tab and shift+tab, and sends any other key to the
+// focused component only.
+func (fm *FocusManager) Update(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "tab":
+		fm.cycle(1)
+		return nil
+	case "shift+tab":
+		fm.cycle(-1)
+		return nil
+	}
+	if h, ok := fm.Focused().(keyHandler); ok {
+		return h.HandleKey(msg)
+	}
+	return nil
+}
+
+// counter is a focusable component that counts key presses.
+type counter struct {
+	name    string
+	count   int
+	focused bool
+}
+
+func (c *counter) Focus() { c.focused = true }
+func (c *counter) Blur()  { c.focused = false }
+
+func (c *counter) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "+", "up":
+		c.count++
+	case "-", "down":
+		c.count--
+	}
+	return nil
+}
+
+func (c *counter) View() string {
+	style := panelStyle
+	if c.focused {
+		style = focusedStyle
+	}
+	return style.Render(fmt.Sprintf("%s\ncount: %d", c.name, c.count))
+}
+
+type model struct {
+	counters []*counter
+	focus    *FocusManager
+}
+
+func newModel(names ...string) model {
+	m := model{focus: NewFocusManager()}
+	for _, name := range names {
+		c := &counter{name: name}
+		m.counters = append(m.counters, c)
+		m.focus.Add(c)
+	}
+	return m
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "x":
+			// Remove the focused counter.
+			if c, ok := m.focus.Focused().(*counter); ok {
+				m.focus.Remove(c)
+				m.counters = slices.DeleteFunc(m.counters, func(other *counter) bool {
+					return other == c
+				})
+			}
+			return m, nil
+		}
+		return m, m.focus.Update(msg)
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	if len(m.counters) == 0 {
+		b.WriteString("Nothing left to focus.\n")
+	} else {
+		views := make([]string, len(m.counters))
+		for i, c := range m.counters {
+			views[i] = c.View()
+		}
+		b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, views...) + "\n")
+	}
+	b.WriteString(helpStyle.Render("tab/shift+tab: focus • +/-: change • x: remove • q: quit") + "\n")
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel("apples", "oranges", "pears"))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
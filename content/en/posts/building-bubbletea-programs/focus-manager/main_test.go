@@ -0,0 +1,100 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestTabThrough(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel("one", "two", "three"), teatest.WithInitialTermSize(80, 20))
+
+	waitForString(t, tm, "three")
+
+	// Increment the first once, the second twice and the third three
+	// times, then come back round to the first and decrement it.
+	tm.Type("+")
+	tm.Send(tea.KeyMsg{Type: tea.KeyTab})
+	tm.Type("++")
+	tm.Send(tea.KeyMsg{Type: tea.KeyTab})
+	tm.Type("+++")
+	tm.Send(tea.KeyMsg{Type: tea.KeyTab})
+	tm.Type("-")
+	// And backwards, to decrement the third.
+	tm.Send(tea.KeyMsg{Type: tea.KeyShiftTab})
+	tm.Type("-")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	for i, want := range []int{0, 2, 2} {
+		if got := fm.counters[i].count; got != want {
+			t.Errorf("counter %q: want %d, got %d", fm.counters[i].name, want, got)
+		}
+	}
+	if fm.focus.Focused() != fm.counters[2] {
+		t.Errorf("expected the third counter to have focus")
+	}
+	for i, c := range fm.counters {
+		if c.focused != (i == 2) {
+			t.Errorf("counter %q: unexpected focused=%v", c.name, c.focused)
+		}
+	}
+}
+
+func TestRemoveFocused(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel("one", "two", "three"), teatest.WithInitialTermSize(80, 20))
+
+	waitForString(t, tm, "three")
+
+	// Removing the second moves focus to the third, which takes its place.
+	tm.Send(tea.KeyMsg{Type: tea.KeyTab})
+	tm.Type("x+")
+	// Removing the last moves focus back to the one before it.
+	tm.Type("x+")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if len(fm.counters) != 1 {
+		t.Fatalf("expected one counter left, got %d", len(fm.counters))
+	}
+	if c := fm.counters[0]; c.name != "one" || c.count != 1 || !c.focused {
+		t.Errorf("unexpected counter: %+v", c)
+	}
+}
+
+func TestRemoveAll(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel("one", "two"), teatest.WithInitialTermSize(80, 20))
+
+	tm.Type("xx")
+
+	waitForString(t, tm, "Nothing left to focus.")
+
+	// With nothing to focus, keys go nowhere.
+	tm.Send(tea.KeyMsg{Type: tea.KeyTab})
+	tm.Send(tea.KeyMsg{Type: tea.KeyShiftTab})
+	tm.Type("+x")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if f := fm.focus.Focused(); f != nil {
+		t.Errorf("expected nothing focused, got %v", f)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
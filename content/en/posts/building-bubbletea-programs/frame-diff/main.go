@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const sidebarWidth = 20
+
+var (
+	headerStyle   = lipgloss.NewStyle().Bold(true).Reverse(true).Padding(0, 1)
+	sidebarStyle  = lipgloss.NewStyle().Width(sidebarWidth).Border(lipgloss.NormalBorder(), false, true, false, false)
+	selectedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	editorStyle   = lipgloss.NewStyle().PaddingLeft(1)
+	statusStyle   = lipgloss.NewStyle().Faint(true)
+)
+
+// region is a part of the screen that is rendered, and cached, separately.
+type region int
+
+const (
+	header region = iota
+	sidebar
+	editor
+	status
+	numRegions
+)
+
+var regionNames = [numRegions]string{"header", "sidebar", "editor", "status"}
+
+type model struct {
+	files    []string
+	selected int
+	text     string
+	width    int
+	height   int
+
+	// dirtyRegions are the regions that have changed since they were last
+	// rendered. Only those are rendered again; the rest are taken from the
+	// cache.
+	dirtyRegions map[region]bool
+	cache        [numRegions]string
+	// renders counts how many times each region has been rendered.
+	renders [numRegions]int
+}
+
+func newModel(files []string) model {
+	m := model{
+		files:        files,
+		width:        80,
+		height:       24,
+		dirtyRegions: make(map[region]bool),
+	}
+	m.invalidate()
+	m.renderDirty()
+	return m
+}
+
+// invalidate marks every region dirty.
+func (m *model) invalidate() {
+	for r := range numRegions {
+		m.dirtyRegions[r] = true
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC, tea.KeyEsc:
+			return m, tea.Quit
+		case tea.KeyTab:
+			m.selected = (m.selected + 1) % len(m.files)
+			m.dirtyRegions[sidebar] = true
+			// The editor shows the selected file's name.
+			m.dirtyRegions[editor] = true
+		case tea.KeyBackspace:
+			if len(m.text) > 0 {
+				// Remove the last rune rather than the last byte, which
+				// could be part of a multi-byte character.
+				_, size := utf8.DecodeLastRuneInString(m.text)
+				m.text = m.text[:len(m.text)-size]
+				m.dirtyRegions[editor] = true
+			}
+		case tea.KeyEnter:
+			m.text += "\n"
+			m.dirtyRegions[editor] = true
+		case tea.KeyRunes, tea.KeySpace:
+			m.text += string(msg.Runes)
+			m.dirtyRegions[editor] = true
+		}
+	case tea.WindowSizeMsg:
+		// Every region depends on the size, so everything is rendered
+		// again.
+		m.width, m.height = msg.Width, msg.Height
+		m.invalidate()
+	}
+	m.renderDirty()
+	return m, nil
+}
+
+// renderDirty renders the dirty regions into the cache. It's called from
+// Update rather than View, because View can't change the model.
+func (m *model) renderDirty() {
+	if len(m.dirtyRegions) == 0 {
+		return
+	}
+	// The status shows the render counts, so it changes whenever anything
+	// else is rendered.
+	m.dirtyRegions[status] = true
+	for r := range numRegions {
+		if !m.dirtyRegions[r] {
+			continue
+		}
+		m.renders[r]++
+		switch r {
+		case header:
+			m.cache[r] = m.headerView()
+		case sidebar:
+			m.cache[r] = m.sidebarView()
+		case editor:
+			m.cache[r] = m.editorView()
+		case status:
+			m.cache[r] = m.statusView()
+		}
+	}
+	clear(m.dirtyRegions)
+}
+
+// bodyHeight is the height left for the sidebar and editor.
+func (m model) bodyHeight() int {
+	return max(1, m.height-2)
+}
+
+func (m model) headerView() string {
+	return headerStyle.Width(m.width).Render("frame-diff")
+}
+
+func (m model) sidebarView() string {
+	lines := make([]string, len(m.files))
+	for i, f := range m.files {
+		if i == m.selected {
+			lines[i] = selectedStyle.Render("> " + f)
+		} else {
+			lines[i] = "  " + f
+		}
+	}
+	return sidebarStyle.Height(m.bodyHeight()).Render(strings.Join(lines, "\n"))
+}
+
+func (m model) editorView() string {
+	width := max(0, m.width-sidebarWidth-1)
+	return editorStyle.Width(width).Height(m.bodyHeight()).Render(m.files[m.selected] + ":\n" + m.text + "█")
+}
+
+func (m model) statusView() string {
+	counts := make([]string, numRegions)
+	for r := range numRegions {
+		counts[r] = fmt.Sprintf("%s %d", regionNames[r], m.renders[r])
+	}
+	return statusStyle.Render("renders: " + strings.Join(counts, " • ") + " • tab: next file • esc: quit")
+}
+
+// View only assembles the cached regions.
+func (m model) View() string {
+	body := lipgloss.JoinHorizontal(lipgloss.Top, m.cache[sidebar], m.cache[editor])
+	return lipgloss.JoinVertical(lipgloss.Left, m.cache[header], body, m.cache[status])
+}
+
+func main() {
+	p := tea.NewProgram(newModel([]string{"main.go", "model.go", "view.go", "README.md"}), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestOnlyDirtyRegionsRender(t *testing.T) {
+	m := newModel([]string{"main.go", "model.go"})
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 20))
+
+	// Once by newModel, and once more for the initial size.
+	waitForString(t, tm, "renders: header 2 • sidebar 2 • editor 2 • status 2")
+
+	tm.Type("hello")
+
+	waitForStrings(t, tm, "hello█", "renders: header 2 • sidebar 2 • editor 7 • status 7")
+
+	// Changing the file changes the sidebar, but still not the header.
+	tm.Send(tea.KeyMsg{Type: tea.KeyTab})
+
+	waitForString(t, tm, "renders: header 2 • sidebar 3 • editor 8 • status 8")
+
+	// Keys that change nothing render nothing.
+	tm.Send(tea.KeyMsg{Type: tea.KeyUp})
+	tm.Send(tea.KeyMsg{Type: tea.KeyLeft})
+
+	// Resizing renders everything again.
+	tm.Send(tea.WindowSizeMsg{Width: 100, Height: 20})
+
+	waitForString(t, tm, "renders: header 3 • sidebar 4 • editor 9 • status 9")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if want := [numRegions]int{3, 4, 9, 9}; fm.renders != want {
+		t.Errorf("want renders %v, got %v", want, fm.renders)
+	}
+}
+
+func TestBackspaceMultiByte(t *testing.T) {
+	var m tea.Model = newModel([]string{"main.go"})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("né")})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	if got := m.(model).text; got != "n" {
+		t.Errorf("want %q, got %q", "n", got)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	waitForStrings(t, tm, s)
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
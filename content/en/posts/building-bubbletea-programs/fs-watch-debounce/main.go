@@ -0,0 +1,189 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/fsnotify/fsnotify"
+)
+
+// quietPeriod is how long to wait after the last change before refreshing.
+const quietPeriod = 200 * time.Millisecond
+
+var (
+	statusStyle = lipgloss.NewStyle().Faint(true)
+	errorStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+)
+
+type (
+	watchingMsg struct{ watcher *fsnotify.Watcher }
+	eventMsg    fsnotify.Event
+	// changedMsg is sent once the period after a change has passed. Only
+	// the one from the latest change triggers a refresh.
+	changedMsg struct{ id int }
+	filesMsg   []string
+	errMsg     struct{ err error }
+	// watchErrMsg is an error reported by the watcher. Unlike errMsg, it
+	// doesn't stop the watching.
+	watchErrMsg struct{ err error }
+)
+
+type model struct {
+	dir       string
+	watcher   *fsnotify.Watcher
+	files     []string
+	changeID  int
+	events    int
+	refreshes int
+	err       error
+	watchErr  error
+}
+
+func newModel(dir string) model {
+	return model{dir: dir}
+}
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(watch(m.dir), list(m.dir))
+}
+
+func watch(dir string) tea.Cmd {
+	return func() tea.Msg {
+		w, err := fsnotify.NewWatcher()
+		if err != nil {
+			return errMsg{err}
+		}
+		if err := w.Add(dir); err != nil {
+			w.Close()
+			return errMsg{err}
+		}
+		return watchingMsg{w}
+	}
+}
+
+// waitForEvent returns a command that blocks until the next event, or
+// error, from the watcher.
+func waitForEvent(w *fsnotify.Watcher) tea.Cmd {
+	return func() tea.Msg {
+		select {
+		case event, ok := <-w.Events:
+			if !ok {
+				return nil
+			}
+			return eventMsg(event)
+		case err, ok := <-w.Errors:
+			if !ok {
+				return nil
+			}
+			return watchErrMsg{err}
+		}
+	}
+}
+
+func list(dir string) tea.Cmd {
+	return func() tea.Msg {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return errMsg{err}
+		}
+		files := make([]string, len(entries))
+		for i, e := range entries {
+			files[i] = e.Name()
+		}
+		return filesMsg(files)
+	}
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			if m.watcher != nil {
+				m.watcher.Close()
+			}
+			return m, tea.Quit
+		}
+	case watchingMsg:
+		m.watcher = msg.watcher
+		return m, waitForEvent(m.watcher)
+	case eventMsg:
+		m.events++
+		if msg.Name == m.dir && fsnotify.Event(msg).Has(fsnotify.Remove) {
+			// The directory itself has gone: there's nothing left to
+			// watch.
+			m.watcher.Close()
+			m.err = errors.New("watched directory was removed")
+			return m, nil
+		}
+		return m, tea.Batch(waitForEvent(m.watcher), m.changed())
+	case watchErrMsg:
+		m.watchErr = msg.err
+		if errors.Is(msg.err, fsnotify.ErrEventOverflow) {
+			// Events have been dropped, so the files shown may be out of
+			// date. Refresh, as if for a change.
+			return m, tea.Batch(waitForEvent(m.watcher), m.changed())
+		}
+		return m, waitForEvent(m.watcher)
+	case changedMsg:
+		if msg.id != m.changeID || m.err != nil {
+			return m, nil
+		}
+		m.refreshes++
+		return m, list(m.dir)
+	case filesMsg:
+		m.files = msg
+		m.watchErr = nil
+	case errMsg:
+		m.err = msg.err
+	}
+	return m, nil
+}
+
+// changed notes a change, returning a command to refresh once the quiet
+// period has passed. Rather than refresh on every event, each change
+// supersedes the previous one's changedMsg, so a burst of events results in
+// a single refresh.
+func (m *model) changed() tea.Cmd {
+	m.changeID++
+	id := m.changeID
+	return tea.Tick(quietPeriod, func(time.Time) tea.Msg {
+		return changedMsg{id: id}
+	})
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %d files\n\n", m.dir, len(m.files))
+	for _, f := range m.files {
+		b.WriteString("  " + f + "\n")
+	}
+	if m.err != nil {
+		b.WriteString("\n" + errorStyle.Render("error: "+m.err.Error()) + "\n")
+	} else if m.watchErr != nil {
+		b.WriteString("\n" + errorStyle.Render("watch error: "+m.watchErr.Error()) + "\n")
+	}
+	state := "starting"
+	if m.watcher != nil {
+		state = "watching"
+	}
+	b.WriteString("\n" + statusStyle.Render(fmt.Sprintf("%s • events: %d • refreshes: %d • q: quit", state, m.events, m.refreshes)) + "\n")
+	return b.String()
+}
+
+func main() {
+	dir := "."
+	if len(os.Args) > 1 {
+		dir = os.Args[1]
+	}
+	p := tea.NewProgram(newModel(dir))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
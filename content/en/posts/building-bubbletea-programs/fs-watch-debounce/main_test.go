@@ -0,0 +1,111 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/fsnotify/fsnotify"
+)
+
+func TestDebounce(t *testing.T) {
+	dir := t.TempDir()
+	tm := teatest.NewTestModel(t, newModel(dir), teatest.WithInitialTermSize(80, 40))
+
+	waitForString(t, tm, "watching")
+
+	// A burst of creates and deletes.
+	for i := 0; i < 10; i++ {
+		path := filepath.Join(dir, fmt.Sprintf("file-%02d.txt", i))
+		if err := os.WriteFile(path, []byte("hello"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if i%2 == 1 {
+			if err := os.Remove(path); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	waitForString(t, tm, "5 files")
+
+	// Wait long enough for any further refreshes to have happened.
+	time.Sleep(2 * quietPeriod)
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	if fm.refreshes != 1 {
+		t.Errorf("expected a single refresh for %d events, got %d", fm.events, fm.refreshes)
+	}
+}
+
+func TestDirectoryRemoved(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "watched")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	tm := teatest.NewTestModel(t, newModel(dir), teatest.WithInitialTermSize(80, 40))
+
+	waitForString(t, tm, "watching")
+
+	if err := os.Remove(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	waitForString(t, tm, "error: watched directory was removed")
+
+	tm.Type("q")
+	tm.WaitFinished(t)
+}
+
+func TestOverflow(t *testing.T) {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	var m tea.Model = newModel(t.TempDir())
+	m, _ = m.Update(watchingMsg{w})
+	m, cmd := m.Update(watchErrMsg{fsnotify.ErrEventOverflow})
+	if cmd == nil {
+		t.Fatal("expected to keep waiting for events")
+	}
+	if got := m.(model).changeID; got != 1 {
+		t.Errorf("expected a refresh to be scheduled, got change %d", got)
+	}
+	if view := m.View(); !strings.Contains(view, "watch error: "+fsnotify.ErrEventOverflow.Error()) {
+		t.Errorf("expected the error to be shown:\n%s", view)
+	}
+
+	// Any other error is shown, but without a refresh.
+	m, cmd = m.Update(watchErrMsg{errors.New("boom")})
+	if cmd == nil || m.(model).changeID != 1 {
+		t.Error("expected to keep waiting for events without a refresh")
+	}
+
+	// The refresh clears the error.
+	m, _ = m.Update(filesMsg{})
+	if view := m.View(); strings.Contains(view, "watch error") {
+		t.Errorf("expected the error to be cleared:\n%s", view)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
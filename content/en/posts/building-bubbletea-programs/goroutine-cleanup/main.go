@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type (
+	// startedMsg is sent once the worker is running, with the channel it
+	// sends its ticks on.
+	startedMsg struct{ ticks <-chan time.Time }
+	tickMsg    time.Time
+)
+
+type model struct {
+	// ctx is cancelled when the program quits, which stops the worker and
+	// anything waiting on it.
+	ctx      context.Context
+	cancel   context.CancelFunc
+	interval time.Duration
+	ticks    <-chan time.Time
+	count    int
+	last     time.Time
+}
+
+func newModel(interval time.Duration) model {
+	ctx, cancel := context.WithCancel(context.Background())
+	return model{ctx: ctx, cancel: cancel, interval: interval}
+}
+
+func (m model) Init() tea.Cmd {
+	return start(m.ctx, m.interval)
+}
+
+// start starts the worker in its own goroutine, which ticks until the
+// context is cancelled. If the program has already quit by the time the
+// command runs, there's nothing to start.
+func start(ctx context.Context, interval time.Duration) tea.Cmd {
+	return func() tea.Msg {
+		if ctx.Err() != nil {
+			return nil
+		}
+		ticks := make(chan time.Time)
+		go func() {
+			defer close(ticks)
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case t := <-ticker.C:
+					select {
+					case ticks <- t:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return startedMsg{ticks: ticks}
+	}
+}
+
+// listen waits for the next tick. It gives up when the context is
+// cancelled, otherwise the command's goroutine would be left blocked
+// after the program exits.
+func listen(ctx context.Context, ticks <-chan time.Time) tea.Cmd {
+	return func() tea.Msg {
+		select {
+		case t, ok := <-ticks:
+			if !ok {
+				return nil
+			}
+			return tickMsg(t)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			m.cancel()
+			return m, tea.Quit
+		}
+	case startedMsg:
+		m.ticks = msg.ticks
+		return m, listen(m.ctx, m.ticks)
+	case tickMsg:
+		m.count++
+		m.last = time.Time(msg)
+		return m, listen(m.ctx, m.ticks)
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	status := "starting worker..."
+	if m.ticks != nil {
+		status = fmt.Sprintf("ticks: %d", m.count)
+		if !m.last.IsZero() {
+			status += " (last at " + m.last.Format("15:04:05") + ")"
+		}
+	}
+	return status + "\n\nq: quit\n"
+}
+
+func main() {
+	m := newModel(time.Second)
+	// Stop the worker however the program ends, not just when quitting.
+	defer m.cancel()
+	p := tea.NewProgram(m)
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+	"go.uber.org/goleak"
+)
+
+// ignoreTeatest ignores the goroutine teatest leaves behind for each test
+// model, waiting for an interrupt that never comes.
+var ignoreTeatest = goleak.IgnoreTopFunction("github.com/charmbracelet/x/exp/teatest.NewTestModel.func2")
+
+func TestNoLeak(t *testing.T) {
+	// Fail the test if any goroutine started from here on is still running
+	// once it has finished, allowing a little while for them to stop.
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent(), ignoreTeatest)
+
+	tm := teatest.NewTestModel(t, newModel(10*time.Millisecond), teatest.WithInitialTermSize(80, 24))
+
+	waitForMatch(t, tm, `ticks: [3-9]`)
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestQuitBeforeStart(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent(), ignoreTeatest)
+
+	m := newModel(10 * time.Millisecond)
+	// Quit before the command that starts the worker has run.
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	if msg := updated.Init()(); msg != nil {
+		t.Errorf("expected the worker not to start, got %T", msg)
+	}
+}
+
+func TestQuitImmediately(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent(), ignoreTeatest)
+
+	tm := teatest.NewTestModel(t, newModel(10*time.Millisecond), teatest.WithInitialTermSize(80, 24))
+
+	// The worker may or may not have started by now, but either way it
+	// must be gone once the program has finished.
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func waitForMatch(t *testing.T, tm *teatest.TestModel, pattern string) {
+	re := regexp.MustCompile(pattern)
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return re.Match(b)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
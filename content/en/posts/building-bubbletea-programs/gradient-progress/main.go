@@ -0,0 +1,104 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+const (
+	barWidth = 40
+	step     = 0.1
+)
+
+var emptyStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("238"))
+
+type model struct {
+	from, to colorful.Color
+	percent  float64
+}
+
+func newModel(from, to string) (model, error) {
+	f, err := colorful.Hex(from)
+	if err != nil {
+		return model{}, fmt.Errorf("invalid from color: %w", err)
+	}
+	t, err := colorful.Hex(to)
+	if err != nil {
+		return model{}, fmt.Errorf("invalid to color: %w", err)
+	}
+	return model{from: f, to: t}, nil
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "right", "l":
+			m.percent = min(1, m.percent+step)
+		case "left", "h":
+			m.percent = max(0, m.percent-step)
+		}
+	}
+	return m, nil
+}
+
+// filled returns the number of cells to fill for the current percentage.
+func (m model) filled() int {
+	return int(math.Round(m.percent * barWidth))
+}
+
+// bar renders the progress bar. The gradient spans the filled cells, so
+// each cell's color depends on how far along the filled part it is, and the
+// last filled cell is always the end color, however little is filled.
+func (m model) bar() string {
+	var b strings.Builder
+	filled := m.filled()
+	for i := range barWidth {
+		if i >= filled {
+			b.WriteString(emptyStyle.Render("░"))
+			continue
+		}
+		// A single filled cell is the end color.
+		t := 1.0
+		if filled > 1 {
+			t = float64(i) / float64(filled-1)
+		}
+		c := m.from.BlendLuv(m.to, t).Clamped()
+		b.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color(c.Hex())).Render("█"))
+	}
+	return b.String()
+}
+
+func (m model) View() string {
+	return fmt.Sprintf("%s %3.0f%%\n\n←/→: adjust • q: quit\n", m.bar(), m.percent*100)
+}
+
+func main() {
+	from := flag.String("from", "#5A56E0", "color at the start of the bar")
+	to := flag.String("to", "#00D787", "color at the end of the bar")
+	flag.Parse()
+
+	m, err := newModel(*from, *to)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	p := tea.NewProgram(m)
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
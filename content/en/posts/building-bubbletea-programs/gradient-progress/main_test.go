@@ -0,0 +1,76 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/muesli/termenv"
+)
+
+func TestHalfway(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	t.Cleanup(func() { lipgloss.SetColorProfile(profile) })
+
+	m, err := newModel("#0000ff", "#00ff00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	for range 5 {
+		tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+	}
+
+	waitForString(t, tm, " 50%")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	bar := fm.bar()
+	if got := strings.Count(bar, "█"); got != barWidth/2 {
+		t.Errorf("expected %d filled cells, got %d", barWidth/2, got)
+	}
+	if got := strings.Count(bar, "\x1b[38;2;"); got != barWidth/2 {
+		t.Errorf("expected %d cells with 24-bit colors, got %d", barWidth/2, got)
+	}
+	// The first cell is the start color.
+	if !strings.HasPrefix(bar, "\x1b[38;2;0;0;255m█") {
+		t.Errorf("expected bar to start with the from color, got %q", bar[:20])
+	}
+	// The last filled cell is the end color, even though the bar is only
+	// half full.
+	if !strings.Contains(bar, "\x1b[38;2;0;255;0m█\x1b[0m"+emptyStyle.Render("░")) {
+		t.Errorf("expected the last filled cell to be the to color, got %q", bar)
+	}
+}
+
+func TestEdges(t *testing.T) {
+	m, err := newModel("#0000ff", "#00ff00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Count(m.bar(), "█"); got != 0 {
+		t.Errorf("expected no filled cells at 0%%, got %d", got)
+	}
+	m.percent = 1
+	if got := strings.Count(m.bar(), "█"); got != barWidth {
+		t.Errorf("expected %d filled cells at 100%%, got %d", barWidth, got)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
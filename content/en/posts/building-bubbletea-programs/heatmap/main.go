@@ -0,0 +1,155 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+const day = 24 * time.Hour
+
+// levelColors are the background colors for each intensity level, from no
+// activity to the most.
+var levelColors = []lipgloss.Color{"#ebedf0", "#9be9a8", "#40c463", "#30a14e", "#216e39"}
+
+var weekdayLabels = []string{"", "Mon", "", "Wed", "", "Fri", ""}
+
+type model struct {
+	renderer   *lipgloss.Renderer
+	values     map[time.Time]int
+	start, end time.Time
+	max        int
+	cursor     time.Time
+}
+
+// newModel returns a heatmap of the values between the start and end dates
+// inclusive. Dates are expected to be at midnight UTC.
+func newModel(profile termenv.Profile, values map[time.Time]int, start, end time.Time) model {
+	r := lipgloss.NewRenderer(os.Stdout)
+	r.SetColorProfile(profile)
+	m := model{renderer: r, values: values, start: start, end: end, cursor: end}
+	for _, v := range values {
+		m.max = max(m.max, v)
+	}
+	return m
+}
+
+// gridStart returns the Sunday on or before the start date. Each column is
+// a week starting on a Sunday, so the first column may begin before the
+// start date, in the previous month or even the previous year.
+func (m model) gridStart() time.Time {
+	return m.start.AddDate(0, 0, -int(m.start.Weekday()))
+}
+
+// weeks returns the number of columns in the grid.
+func (m model) weeks() int {
+	return int(m.end.Sub(m.gridStart())/day)/7 + 1
+}
+
+// level buckets a value into one of the intensity levels. Zero is always
+// level 0, and any activity at all is at least level 1.
+func (m model) level(v int) int {
+	if v <= 0 || m.max == 0 {
+		return 0
+	}
+	n := len(levelColors) - 1
+	return min(n, (v*n+m.max-1)/m.max)
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		var cursor time.Time
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			cursor = m.cursor.AddDate(0, 0, -1)
+		case "down", "j":
+			cursor = m.cursor.AddDate(0, 0, 1)
+		case "left", "h":
+			cursor = m.cursor.AddDate(0, 0, -7)
+		case "right", "l":
+			cursor = m.cursor.AddDate(0, 0, 7)
+		default:
+			return m, nil
+		}
+		if !cursor.Before(m.start) && !cursor.After(m.end) {
+			m.cursor = cursor
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	weeks := m.weeks()
+	gridStart := m.gridStart()
+
+	// Label each month above the week in which it starts, unless it would
+	// overlap the previous label.
+	// Leave room for a label above the last column.
+	months := []byte(strings.Repeat(" ", 4+weeks*2+len("Jan")))
+	next := 0
+	for w := 0; w < weeks; w++ {
+		first := gridStart.AddDate(0, 0, w*7)
+		if w > 0 && first.AddDate(0, 0, -7).Month() == first.Month() {
+			continue
+		}
+		pos := 4 + w*2
+		if pos < next {
+			continue
+		}
+		next = pos + copy(months[pos:], first.Format("Jan")) + 1
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.TrimRight(string(months), " ") + "\n")
+	for d := 0; d < 7; d++ {
+		fmt.Fprintf(&b, "%-4s", weekdayLabels[d])
+		for w := 0; w < weeks; w++ {
+			date := gridStart.AddDate(0, 0, w*7+d)
+			if date.Before(m.start) || date.After(m.end) {
+				b.WriteString("  ")
+				continue
+			}
+			style := m.renderer.NewStyle().Background(levelColors[m.level(m.values[date])])
+			cell := "  "
+			if date.Equal(m.cursor) {
+				cell = "◆ "
+				style = style.Foreground(lipgloss.Color("#d73a49"))
+			}
+			b.WriteString(style.Render(cell))
+		}
+		b.WriteString("\n")
+	}
+	v := m.values[m.cursor]
+	fmt.Fprintf(&b, "\n%s: %d contributions\n\n", m.cursor.Format("Mon 2 Jan 2006"), v)
+	b.WriteString("arrows: move • q: quit\n")
+	return b.String()
+}
+
+func main() {
+	end := time.Now().UTC().Truncate(day)
+	start := end.AddDate(0, 0, -7*26)
+	values := make(map[time.Time]int)
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if rand.Intn(3) > 0 {
+			values[d] = rand.Intn(20)
+		}
+	}
+	p := tea.NewProgram(newModel(lipgloss.ColorProfile(), values, start, end))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/muesli/termenv"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestHeatmap(t *testing.T) {
+	// Spans the end of the year: 27 Dec 2023 is a Wednesday, so the first
+	// week starts on Sunday 24 Dec.
+	start, end := date(2023, 12, 27), date(2024, 1, 10)
+	values := map[time.Time]int{
+		date(2023, 12, 28): 2,
+		date(2024, 1, 1):   0,
+		date(2024, 1, 2):   10,
+		date(2024, 1, 5):   6,
+	}
+	m := newModel(termenv.TrueColor, values, start, end)
+	if got := m.weeks(); got != 3 {
+		t.Errorf("expected 3 weeks, got %d", got)
+	}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "Wed 10 Jan 2024: 0 contributions")
+
+	// Move back a week, then up a day to Tuesday 2 Jan.
+	tm.Send(tea.KeyMsg{Type: tea.KeyLeft})
+	tm.Send(tea.KeyMsg{Type: tea.KeyUp})
+
+	waitForString(t, tm, "Tue 2 Jan 2024: 10 contributions")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	if got := fm.level(values[date(2024, 1, 2)]); got != len(levelColors)-1 {
+		t.Errorf("expected highest value to be at the highest level, got %d", got)
+	}
+	if got := fm.level(values[date(2024, 1, 1)]); got != 0 {
+		t.Errorf("expected zero value to be at level 0, got %d", got)
+	}
+	if got := fm.level(1); got != 1 {
+		t.Errorf("expected any activity to be at least level 1, got %d", got)
+	}
+
+	// The cursor is on the highest value, so its cell is the one rendered
+	// with the darkest color.
+	view := fm.View()
+	// #216e39, as rendered by termenv.
+	darkest := "48;2;32;110;56m◆ "
+	if n := strings.Count(view, "48;2;32;110;56m"); n != 1 {
+		t.Errorf("expected one cell in the darkest color, got %d", n)
+	}
+	if !strings.Contains(view, darkest) {
+		t.Errorf("expected the cursor's cell to be in the darkest color, got:\n%q", view)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
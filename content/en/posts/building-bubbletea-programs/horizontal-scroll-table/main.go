@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	pinnedWidth = 12
+	columnWidth = 10
+	// indicatorWidth is the space reserved either side of the scrollable
+	// columns for the hidden column indicators.
+	indicatorWidth = 2
+)
+
+var (
+	headerStyle = lipgloss.NewStyle().Bold(true)
+	pinnedStyle = lipgloss.NewStyle().Width(pinnedWidth).Foreground(lipgloss.Color("205"))
+	cellStyle   = lipgloss.NewStyle().Width(columnWidth).Align(lipgloss.Right)
+)
+
+type model struct {
+	headers []string
+	rows    [][]string
+	// offset is the index of the first visible scrollable column.
+	offset int
+	width  int
+}
+
+func newModel() model {
+	headers := []string{"Region", "Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}
+	regions := []string{"North", "South", "East", "West", "Central"}
+	rows := make([][]string, len(regions))
+	for i, region := range regions {
+		row := []string{region}
+		for j := 1; j < len(headers); j++ {
+			row = append(row, fmt.Sprintf("%d", (i+1)*100+j*7))
+		}
+		rows[i] = row
+	}
+	return model{headers: headers, rows: rows}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "left", "h":
+			m.offset = max(0, m.offset-1)
+		case "right", "l":
+			m.offset = min(m.maxOffset(), m.offset+1)
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.offset = min(m.maxOffset(), m.offset)
+	}
+	return m, nil
+}
+
+// scrollable returns the number of unpinned columns.
+func (m model) scrollable() int {
+	return len(m.headers) - 1
+}
+
+// visible returns the number of unpinned columns that fit in the terminal.
+func (m model) visible() int {
+	n := (m.width - pinnedWidth - 2*indicatorWidth) / columnWidth
+	return max(1, min(n, m.scrollable()))
+}
+
+func (m model) maxOffset() int {
+	return m.scrollable() - m.visible()
+}
+
+func (m model) View() string {
+	left, right := "  ", "  "
+	if m.offset > 0 {
+		left = "◀ "
+	}
+	if m.offset < m.maxOffset() {
+		right = " ▶"
+	}
+
+	var b strings.Builder
+	b.WriteString(headerStyle.Render(m.renderRow(m.headers, left, right)))
+	b.WriteString("\n")
+	for _, row := range m.rows {
+		b.WriteString(m.renderRow(row, left, right))
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "\ncolumns %d-%d of %d • ←/→: scroll • q: quit",
+		m.offset+1, m.offset+m.visible(), m.scrollable())
+	return b.String()
+}
+
+func (m model) renderRow(row []string, left, right string) string {
+	cells := []string{pinnedStyle.Render(row[0]), left}
+	for _, cell := range row[1+m.offset : 1+m.offset+m.visible()] {
+		cells = append(cells, cellStyle.Render(cell))
+	}
+	cells = append(cells, right)
+	return lipgloss.JoinHorizontal(lipgloss.Top, cells...)
+}
+
+func main() {
+	p := tea.NewProgram(newModel(), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestScroll(t *testing.T) {
+	m := newModel()
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(60, 10))
+
+	waitForMatch(t, tm, `Region\s+Jan\s+Feb\s+Mar\s+Apr\s+▶`)
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+
+	waitForMatch(t, tm, `Region\s+◀\s+Feb\s+Mar\s+Apr\s+May\s+▶`)
+
+	// Scroll past the end: the last window stops at Dec.
+	for range 20 {
+		tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+	}
+
+	waitForMatch(t, tm, `Region\s+◀\s+Sep\s+Oct\s+Nov\s+Dec\s*\n`)
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.offset != fm.maxOffset() {
+		t.Errorf("expected offset %d, got %d", fm.maxOffset(), fm.offset)
+	}
+}
+
+func waitForMatch(t *testing.T, tm *teatest.TestModel, pattern string) {
+	re := regexp.MustCompile(pattern)
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return re.Match(b)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type tickMsg struct{}
+
+type model struct {
+	lines    []string
+	limit    int
+	interval time.Duration
+	height   int
+	quitting bool
+}
+
+func newModel(limit int, interval time.Duration) model {
+	return model{limit: limit, interval: interval}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.tick()
+}
+
+func (m model) tick() tea.Cmd {
+	return tea.Tick(m.interval, func(time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			m.quitting = true
+			// The live view only shows the tail of the output, so print the
+			// lot above it to leave a full record in the scrollback.
+			return m, tea.Sequence(tea.Println(strings.Join(m.lines, "\n")), tea.Quit)
+		case "c":
+			return m, tea.ClearScreen
+		}
+	case tea.WindowSizeMsg:
+		m.height = msg.Height
+	case tickMsg:
+		if len(m.lines) == m.limit {
+			return m, nil
+		}
+		m.lines = append(m.lines, fmt.Sprintf("line %02d: processed item", len(m.lines)+1))
+		return m, m.tick()
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.quitting {
+		return fmt.Sprintf("Done: %d lines.\n", len(m.lines))
+	}
+
+	// In inline mode the renderer discards lines that don't fit in the
+	// terminal, so explicitly show only the most recent lines, leaving room
+	// for the overflow and help lines.
+	lines := m.lines
+	var overflow string
+	if visible := max(0, m.height-2); m.height > 0 && len(lines) > visible {
+		hidden := min(len(lines), len(lines)-visible)
+		overflow = fmt.Sprintf("(%d more above)\n", hidden)
+		lines = lines[hidden:]
+	}
+	return overflow + strings.Join(lines, "\n") + "\nc: clear screen • q: quit"
+}
+
+func main() {
+	p := tea.NewProgram(newModel(50, 200*time.Millisecond))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
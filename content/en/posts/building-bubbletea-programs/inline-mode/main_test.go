@@ -0,0 +1,72 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestFinalOutput(t *testing.T) {
+	m := newModel(20, 10*time.Millisecond)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 10))
+
+	waitForString(t, tm, "line 20: processed item")
+
+	tm.Type("q")
+
+	var out strings.Builder
+	teatest.WaitFor(
+		t,
+		tm.FinalOutput(t, teatest.WithFinalTimeout(time.Second)),
+		func(b []byte) bool {
+			out.Write(b)
+			return true
+		},
+	)
+	for _, want := range []string{"line 01: processed item", "line 20: processed item", "Done: 20 lines."} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected final output to contain %q, got %q", want, out.String())
+		}
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	m := newModel(20, time.Second)
+	m.height = 10
+	for range 20 {
+		m.lines = append(m.lines, "line")
+	}
+	view := m.View()
+	if got := strings.Count(view, "\n") + 1; got > m.height {
+		t.Errorf("expected view to fit in %d lines, got %d", m.height, got)
+	}
+	if !strings.Contains(view, "(12 more above)") {
+		t.Errorf("expected overflow indicator, got %q", view)
+	}
+}
+
+func TestTinyTerminal(t *testing.T) {
+	var m tea.Model = newModel(20, time.Second)
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 80, Height: 1})
+	mm := m.(model)
+	mm.lines = []string{"line 01", "line 02"}
+	// No room for any lines, but it mustn't panic.
+	if view := mm.View(); !strings.Contains(view, "(2 more above)") {
+		t.Errorf("expected overflow indicator, got %q", view)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const throttleInterval = 50 * time.Millisecond
+
+type throttleMsg struct{}
+
+type model struct {
+	position int
+	preview  int
+	keys     int
+	actions  int
+	// throttled is true while within an interval following an action.
+	throttled bool
+	// pending is true if keys have arrived during the current interval and
+	// the action needs to run again once it expires.
+	pending bool
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.position++
+		case "down", "j":
+			m.position--
+		default:
+			return m, nil
+		}
+		m.keys++
+		if m.throttled {
+			m.pending = true
+			return m, nil
+		}
+		// Run the first keystroke immediately so a single press isn't
+		// delayed by the throttle.
+		m.action()
+		m.throttled = true
+		return m, throttle()
+	case throttleMsg:
+		if !m.pending {
+			m.throttled = false
+			return m, nil
+		}
+		m.pending = false
+		m.action()
+		return m, throttle()
+	}
+	return m, nil
+}
+
+// action stands in for something expensive, such as re-rendering a preview
+// of the item under the cursor.
+func (m *model) action() {
+	m.actions++
+	m.preview = m.position
+}
+
+func throttle() tea.Cmd {
+	return tea.Tick(throttleInterval, func(time.Time) tea.Msg {
+		return throttleMsg{}
+	})
+}
+
+func (m model) View() string {
+	return fmt.Sprintf(
+		"position: %d\npreview:  %d\n\nkeys received: %d\nactions run:   %d\n\nhold ↑/↓ to move • q: quit\n",
+		m.position, m.preview, m.keys, m.actions,
+	)
+}
+
+func main() {
+	p := tea.NewProgram(model{})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
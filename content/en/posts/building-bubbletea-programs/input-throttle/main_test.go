@@ -0,0 +1,60 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestBurst(t *testing.T) {
+	m := model{}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	for range 100 {
+		tm.Send(tea.KeyMsg{Type: tea.KeyUp})
+	}
+
+	// The trailing action catches up with the final position.
+	waitForString(t, tm, "preview:  100")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.keys != 100 {
+		t.Errorf("expected 100 keys, got %d", fm.keys)
+	}
+	if fm.actions > 10 {
+		t.Errorf("expected far fewer actions than keys, got %d", fm.actions)
+	}
+}
+
+func TestSingleKey(t *testing.T) {
+	m := model{}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyUp})
+
+	waitForString(t, tm, "actions run:   1")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.preview != 1 {
+		t.Errorf("expected preview to be updated immediately, got %d", fm.preview)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+
+type model struct {
+	items  []string
+	cursor int
+}
+
+func newModel(items []string) model {
+	items = slices.Clone(items)
+	slices.SortFunc(items, func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	return model{items: items}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "esc":
+			return m, tea.Quit
+		case "up":
+			m.cursor = max(0, m.cursor-1)
+		case "down":
+			m.cursor = max(0, min(len(m.items)-1, m.cursor+1))
+		case "home":
+			m.cursor = 0
+		case "end":
+			m.cursor = max(0, len(m.items)-1)
+		default:
+			if msg.Type == tea.KeyRunes && len(msg.Runes) == 1 && unicode.IsLetter(msg.Runes[0]) {
+				m.jump(msg.Runes[0])
+			}
+		}
+	}
+	return m, nil
+}
+
+// jump moves the cursor to the next item, after the current one, that starts
+// with the letter, wrapping around to the top. Pressing the same letter again
+// therefore cycles through all the items starting with it.
+func (m *model) jump(letter rune) {
+	letter = unicode.ToLower(letter)
+	for i := 1; i <= len(m.items); i++ {
+		idx := (m.cursor + i) % len(m.items)
+		first, _ := firstRune(m.items[idx])
+		if unicode.ToLower(first) == letter {
+			m.cursor = idx
+			return
+		}
+	}
+}
+
+func firstRune(s string) (rune, bool) {
+	for _, r := range s {
+		return r, true
+	}
+	return 0, false
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	for i, item := range m.items {
+		if i == m.cursor {
+			b.WriteString(cursorStyle.Render("> " + item))
+		} else {
+			b.WriteString("  " + item)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\na-z: jump to letter • ↑/↓: move • esc: quit\n")
+	return b.String()
+}
+
+func main() {
+	m := newModel([]string{
+		"Banana", "apple", "Cherry", "avocado", "blueberry", "cranberry",
+		"Apricot", "date", "elderberry", "fig", "grape", "guava",
+	})
+	p := tea.NewProgram(m)
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestJump(t *testing.T) {
+	m := newModel([]string{"Banana", "apple", "Cherry", "blueberry", "Apricot", "cranberry"})
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "> apple")
+
+	tm.Type("b")
+
+	waitForString(t, tm, "> Banana")
+
+	// Pressing the same letter again advances to the next match,
+	// regardless of case.
+	tm.Type("B")
+
+	waitForString(t, tm, "> blueberry")
+
+	tm.Type("b")
+
+	waitForString(t, tm, "> Banana")
+
+	// No items start with z.
+	tm.Type("z")
+
+	tm.Type("c")
+
+	waitForString(t, tm, "> Cherry")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.items[fm.cursor]; got != "Cherry" {
+		t.Errorf("expected cursor on Cherry, got %q", got)
+	}
+}
+
+func TestNoMatch(t *testing.T) {
+	m := newModel([]string{"apple", "banana"})
+	m.cursor = 1
+	m.jump('z')
+	if m.cursor != 1 {
+		t.Errorf("expected jump to a letter with no matches to be a no-op, got cursor %d", m.cursor)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
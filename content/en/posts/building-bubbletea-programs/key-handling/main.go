@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	labelStyle = lipgloss.NewStyle().Width(8).Faint(true)
+	kindStyle  = lipgloss.NewStyle().Bold(true)
+)
+
+type model struct {
+	last  tea.KeyMsg
+	kind  string
+	typed string
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			return m, tea.Quit
+		}
+		m.last = msg
+		switch {
+		case msg.Alt:
+			// Alt+a arrives as the rune 'a' with Alt set. It's a shortcut,
+			// not text, so check for it before treating runes as text.
+			m.kind = "alt-modified"
+		case msg.Type == tea.KeyRunes, msg.Type == tea.KeySpace:
+			// Printable characters, including the spacebar, which has its
+			// own type but still carries its rune. Use msg.Runes rather
+			// than msg.String(), which is meant for matching keys and
+			// wraps pasted text in brackets.
+			m.kind = "printable"
+			m.typed += string(msg.Runes)
+		default:
+			m.kind = "special"
+			switch msg.Type {
+			case tea.KeyBackspace:
+				if r := []rune(m.typed); len(r) > 0 {
+					m.typed = string(r[:len(r)-1])
+				}
+			case tea.KeyEnter:
+				m.typed = ""
+			}
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.kind == "" {
+		return "press any key • ctrl+c: quit\n"
+	}
+	var b strings.Builder
+	b.WriteString(labelStyle.Render("kind") + kindStyle.Render(m.kind) + "\n")
+	b.WriteString(labelStyle.Render("string") + m.last.String() + "\n")
+	b.WriteString(labelStyle.Render("type") + m.last.Type.String() + "\n")
+	b.WriteString(labelStyle.Render("runes") + fmt.Sprintf("%q", m.last.Runes) + "\n")
+	b.WriteString(labelStyle.Render("alt") + fmt.Sprint(m.last.Alt) + "\n")
+	b.WriteString("\n" + labelStyle.Render("typed") + m.typed + "\n")
+	b.WriteString("\nenter: clear • ctrl+c: quit\n")
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(model{})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestKeys(t *testing.T) {
+	tm := teatest.NewTestModel(t, model{}, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "press any key")
+
+	tm.Type("a")
+
+	waitForString(t, tm, "kind    printable\r\nstring  a\r\ntype    runes")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyUp})
+
+	waitForString(t, tm, "kind    special\r\nstring  up\r\ntype    up")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyF5})
+
+	waitForString(t, tm, "string  f5\r\ntype    f5")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}, Alt: true})
+
+	waitForString(t, tm, "kind    alt-modified\r\nstring  alt+x")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+
+	waitForString(t, tm, "kind    printable\r\nstring   \r\ntype    ")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	fm := tm.FinalModel(t).(model)
+	// The alt-modified key isn't typed, but the space is.
+	if fm.typed != "a " {
+		t.Errorf("expected typed to be %q, got %q", "a ", fm.typed)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
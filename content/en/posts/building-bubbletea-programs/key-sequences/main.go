@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	cursorStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	pendingStyle = lipgloss.NewStyle().Reverse(true)
+)
+
+// prefixes are keys that begin a sequence.
+var prefixes = map[string]bool{"g": true, "d": true}
+
+// sequenceTimeoutMsg is sent when a pending sequence times out. The id
+// identifies which sequence timed out, so that a stale timeout for an earlier
+// sequence is ignored.
+type sequenceTimeoutMsg struct{ id int }
+
+type model struct {
+	items   []string
+	cursor  int
+	timeout time.Duration
+	// pending is the first key of a sequence awaiting its second key.
+	pending   string
+	pendingID int
+}
+
+func newModel(timeout time.Duration) model {
+	items := make([]string, 20)
+	for i := range items {
+		items[i] = fmt.Sprintf("item %02d", i+1)
+	}
+	return model{items: items, timeout: timeout}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		key := msg.String()
+		if key == "ctrl+c" || key == "q" {
+			return m, tea.Quit
+		}
+		if m.pending != "" {
+			seq := m.pending + key
+			m.pending = ""
+			if m.sequence(seq) {
+				return m, nil
+			}
+			// The sequence didn't match, so drop the prefix and treat the
+			// second key as if it were pressed on its own.
+		}
+		if prefixes[key] {
+			m.pending = key
+			m.pendingID++
+			id := m.pendingID
+			return m, tea.Tick(m.timeout, func(time.Time) tea.Msg {
+				return sequenceTimeoutMsg{id: id}
+			})
+		}
+		m.single(key)
+	case sequenceTimeoutMsg:
+		if msg.id == m.pendingID {
+			m.pending = ""
+		}
+	}
+	return m, nil
+}
+
+// sequence handles a two key sequence, reporting whether it was recognised.
+func (m *model) sequence(seq string) bool {
+	switch seq {
+	case "gg":
+		m.cursor = 0
+	case "dd":
+		if len(m.items) == 0 {
+			return true
+		}
+		m.items = append(m.items[:m.cursor], m.items[m.cursor+1:]...)
+		m.cursor = max(0, min(m.cursor, len(m.items)-1))
+	default:
+		return false
+	}
+	return true
+}
+
+func (m *model) single(key string) {
+	switch key {
+	case "j", "down":
+		m.cursor = min(m.cursor+1, len(m.items)-1)
+	case "k", "up":
+		m.cursor = max(m.cursor-1, 0)
+	case "G":
+		m.cursor = max(0, len(m.items)-1)
+	}
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	for i, item := range m.items {
+		if i == m.cursor {
+			b.WriteString(cursorStyle.Render("> " + item))
+		} else {
+			b.WriteString("  " + item)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	if m.pending != "" {
+		b.WriteString(pendingStyle.Render(" " + m.pending + "… "))
+	} else {
+		b.WriteString("j/k: move • gg: top • G: bottom • dd: delete • q: quit")
+	}
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel(time.Second), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
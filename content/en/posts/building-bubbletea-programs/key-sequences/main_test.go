@@ -0,0 +1,99 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestGoToTop(t *testing.T) {
+	m := newModel(time.Second)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 30))
+
+	tm.Type("jjjj")
+
+	waitForString(t, tm, "> item 05")
+
+	tm.Type("g")
+
+	waitForString(t, tm, "g…")
+
+	tm.Type("g")
+
+	waitForString(t, tm, "> item 01")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.cursor != 0 {
+		t.Errorf("expected cursor at top, got %d", fm.cursor)
+	}
+}
+
+func TestDeleteLine(t *testing.T) {
+	m := newModel(time.Second)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 30))
+
+	tm.Type("jdd")
+
+	waitForString(t, tm, "> item 03")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if len(fm.items) != 19 || fm.items[1] != "item 03" {
+		t.Errorf("expected item 02 to be deleted, got %v", fm.items)
+	}
+}
+
+func TestUnmatchedSequence(t *testing.T) {
+	m := newModel(time.Second)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 30))
+
+	// "gj" isn't a sequence, so the "j" is handled on its own.
+	tm.Type("gj")
+
+	waitForString(t, tm, "> item 02")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.pending != "" {
+		t.Errorf("expected pending sequence to be reset, got %q", fm.pending)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	m := newModel(50 * time.Millisecond)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 30))
+
+	tm.Type("jjg")
+
+	waitForString(t, tm, "> item 03")
+
+	time.Sleep(200 * time.Millisecond)
+
+	// The first "g" timed out, so this one starts a new sequence rather
+	// than completing "gg".
+	tm.Type("g")
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.cursor != 2 {
+		t.Errorf("expected cursor to stay put, got %d", fm.cursor)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
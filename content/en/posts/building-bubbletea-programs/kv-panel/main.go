@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
+)
+
+// maxKeyWidth caps the width of the key column, so that one long key
+// doesn't squeeze every value.
+const maxKeyWidth = 14
+
+// separator goes between a key and its value.
+const separator = "  "
+
+var (
+	panelStyle = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+	keyStyle   = lipgloss.NewStyle().Bold(true)
+	emptyStyle = lipgloss.NewStyle().Faint(true)
+)
+
+type pair struct {
+	key, value string
+}
+
+// renderPairs renders the pairs in two columns within the width: keys
+// right-aligned to the longest key, up to maxKeyWidth, and values wrapped
+// beneath themselves, indented to line up with the first line.
+func renderPairs(pairs []pair, width int) string {
+	keyWidth := 0
+	for _, p := range pairs {
+		keyWidth = max(keyWidth, lipgloss.Width(p.key))
+	}
+	keyWidth = min(keyWidth, maxKeyWidth)
+	// The value column is always at least one cell wide, however narrow
+	// the panel.
+	valueWidth := max(1, width-keyWidth-len(separator))
+	indent := strings.Repeat(" ", keyWidth+len(separator))
+
+	var lines []string
+	for _, p := range pairs {
+		key := truncate.StringWithTail(p.key, uint(keyWidth), "…")
+		key = strings.Repeat(" ", keyWidth-lipgloss.Width(key)) + keyStyle.Render(key)
+		if p.value == "" {
+			lines = append(lines, key+separator+emptyStyle.Render("(empty)"))
+			continue
+		}
+		// Words longer than the column are broken, so a value can't
+		// overflow it.
+		wrapped := lipgloss.NewStyle().Width(valueWidth).Render(p.value)
+		for i, line := range strings.Split(wrapped, "\n") {
+			line = strings.TrimRight(line, " ")
+			if i == 0 {
+				lines = append(lines, key+separator+line)
+			} else {
+				lines = append(lines, indent+line)
+			}
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+type model struct {
+	pairs []pair
+	width int
+}
+
+func newModel(pairs []pair) model {
+	return model{pairs: pairs, width: 80}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	width := max(0, m.width-panelStyle.GetHorizontalFrameSize())
+	return panelStyle.Width(width+panelStyle.GetHorizontalPadding()).Render(renderPairs(m.pairs, width)) + "\n"
+}
+
+func main() {
+	pairs := []pair{
+		{"Name", "web-frontend"},
+		{"Namespace", "production"},
+		{"Image", "registry.example.com/platform/web-frontend:v2.14.3-rc1"},
+		{"Description", "Serves the public website and proxies API requests to the backend services, with caching for static assets."},
+		{"Owner", ""},
+		{"Last deployment status", "succeeded"},
+	}
+	p := tea.NewProgram(newModel(pairs))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestPanel(t *testing.T) {
+	pairs := []pair{
+		{"Name", "web-frontend"},
+		{"Description", "Serves the public website and proxies API requests."},
+		{"Owner", ""},
+		{"Digest", "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822c"},
+		{"Last deployment status", "succeeded"},
+	}
+	tm := teatest.NewTestModel(t, newModel(pairs), teatest.WithInitialTermSize(40, 20))
+
+	waitForStrings(t, tm,
+		// Keys are right-aligned, capped at the maximum key width.
+		"│           Name  web-frontend",
+		// Long values wrap beneath themselves.
+		"│    Description  Serves the public",
+		"│                 website and proxies",
+		"│                 API requests.",
+		"│          Owner  (empty)",
+		// Values longer than the column are broken.
+		"│         Digest  9f86d081884c7d659a2f",
+		"│                 eaa0c55ad015a3bf4f1b",
+		"│                 2b0b822c",
+		"│ Last deployme…  succeeded",
+	)
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
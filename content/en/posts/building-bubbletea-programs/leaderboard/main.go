@@ -0,0 +1,233 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"math/rand"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	titleStyle  = lipgloss.NewStyle().Bold(true).MarginBottom(1)
+	upStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+	downStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	newStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Bold(true)
+	movingStyle = lipgloss.NewStyle().Bold(true)
+	statusStyle = lipgloss.NewStyle().Faint(true)
+)
+
+// source returns the latest score of every player.
+type source func() map[string]int
+
+type entry struct {
+	name  string
+	score int
+	// rank is the entry's position in the standings, shared with any
+	// entries on the same score. previous is its rank before the last
+	// update.
+	rank, previous int
+	added          bool
+	// y is the row the entry is currently drawn at, and target is the row
+	// it is moving towards, one row per tick.
+	y, target int
+}
+
+type (
+	scoresMsg map[string]int
+	tickMsg   struct{}
+)
+
+type model struct {
+	source   source
+	refresh  time.Duration
+	interval time.Duration
+	entries  []entry
+	// animating is true while a tick is scheduled, so that an update
+	// arriving mid-animation doesn't start a second tick loop.
+	animating bool
+}
+
+func newModel(source source, refresh, interval time.Duration) model {
+	return model{source: source, refresh: refresh, interval: interval}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.fetch
+}
+
+func (m model) fetch() tea.Msg {
+	return scoresMsg(m.source())
+}
+
+func (m model) poll() tea.Cmd {
+	return tea.Tick(m.refresh, func(time.Time) tea.Msg {
+		return m.fetch()
+	})
+}
+
+func (m *model) animate() tea.Cmd {
+	if m.animating {
+		return nil
+	}
+	m.animating = true
+	return tea.Tick(m.interval, func(time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case scoresMsg:
+		m.rerank(msg)
+		return m, tea.Batch(m.poll(), m.animate())
+	case tickMsg:
+		m.animating = false
+		moving := false
+		for i := range m.entries {
+			e := &m.entries[i]
+			switch {
+			case e.y < e.target:
+				e.y++
+			case e.y > e.target:
+				e.y--
+			}
+			moving = moving || e.y != e.target
+		}
+		if moving {
+			return m, m.animate()
+		}
+	}
+	return m, nil
+}
+
+// rerank applies the latest scores and works out where each entry should
+// now be. The entries themselves don't move until the animation catches
+// up.
+func (m *model) rerank(scores map[string]int) {
+	first := len(m.entries) == 0
+	entries := m.entries[:0]
+	for _, e := range m.entries {
+		score, ok := scores[e.name]
+		if !ok {
+			continue
+		}
+		e.score, e.added = score, false
+		entries = append(entries, e)
+	}
+	var added []string
+	for name := range scores {
+		if !slices.ContainsFunc(entries, func(e entry) bool { return e.name == name }) {
+			added = append(added, name)
+		}
+	}
+	slices.Sort(added)
+	for _, name := range added {
+		// New entrants slide in from the bottom, after everyone else.
+		entries = append(entries, entry{
+			name:   name,
+			score:  scores[name],
+			added:  !first,
+			y:      len(entries),
+			target: len(entries),
+		})
+	}
+	m.entries = entries
+	for i := range m.entries {
+		// Rows left by players who have dropped out close up at once.
+		m.entries[i].y = min(m.entries[i].y, len(m.entries)-1)
+	}
+
+	// Entries on the same score stay in the order they were already in,
+	// so that ties don't shuffle back and forth.
+	order := make([]*entry, len(m.entries))
+	for i := range m.entries {
+		order[i] = &m.entries[i]
+	}
+	slices.SortStableFunc(order, func(a, b *entry) int {
+		return cmp.Or(cmp.Compare(b.score, a.score), cmp.Compare(a.target, b.target))
+	})
+	for i, e := range order {
+		e.previous = e.rank
+		e.rank = i + 1
+		if i > 0 && order[i-1].score == e.score {
+			e.rank = order[i-1].rank
+		}
+		e.target = i
+		if first {
+			e.previous, e.y = e.rank, i
+		}
+	}
+}
+
+func (e entry) indicator() string {
+	switch {
+	case e.added:
+		return newStyle.Render("NEW")
+	case e.rank < e.previous:
+		return upStyle.Render(fmt.Sprintf("▲%d", e.previous-e.rank))
+	case e.rank > e.previous:
+		return downStyle.Render(fmt.Sprintf("▼%d", e.rank-e.previous))
+	}
+	return ""
+}
+
+func (m model) View() string {
+	if len(m.entries) == 0 {
+		return "loading scores...\n"
+	}
+	rows := make([]string, len(m.entries))
+	// Draw the entries that are climbing last, so they pass in front of
+	// the ones they overtake.
+	order := make([]entry, len(m.entries))
+	copy(order, m.entries)
+	slices.SortStableFunc(order, func(a, b entry) int {
+		return cmp.Compare(b.y-b.target, a.y-a.target)
+	})
+	for _, e := range order {
+		line := fmt.Sprintf("%2d. %-10s %6d  %s", e.rank, e.name, e.score, e.indicator())
+		if e.y != e.target {
+			line = movingStyle.Render(line)
+		}
+		rows[e.y] = line
+	}
+	return titleStyle.Render("Leaderboard") + "\n" + strings.Join(rows, "\n") + "\n\n" + statusStyle.Render("q: quit") + "\n"
+}
+
+// randomScores adds points to a few of the players each time it is called,
+// and now and then a new player joins.
+func randomScores() source {
+	scores := map[string]int{"ada": 120, "grace": 110, "linus": 95, "ken": 80, "barbara": 80, "dennis": 60}
+	joining := []string{"margaret", "alan"}
+	return func() map[string]int {
+		for name := range scores {
+			if rand.Intn(3) == 0 {
+				scores[name] += rand.Intn(30)
+			}
+		}
+		if len(joining) > 0 && rand.Intn(4) == 0 {
+			scores[joining[0]] = rand.Intn(100)
+			joining = joining[1:]
+		}
+		return maps.Clone(scores)
+	}
+}
+
+func main() {
+	p := tea.NewProgram(newModel(randomScores(), 2*time.Second, 120*time.Millisecond))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
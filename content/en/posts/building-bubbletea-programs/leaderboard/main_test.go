@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func row(rank int, name string, score int, indicator string) string {
+	return fmt.Sprintf("%2d. %-10s %6d  %s", rank, name, score, indicator)
+}
+
+func TestOvertake(t *testing.T) {
+	// Each fetch waits for the test to send the next scores.
+	updates := make(chan map[string]int)
+	m := newModel(func() map[string]int { return <-updates }, time.Millisecond, 10*time.Millisecond)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	updates <- map[string]int{"alice": 30, "bob": 20, "carol": 10}
+
+	waitForStrings(t, tm, row(1, "alice", 30, ""), row(3, "carol", 10, ""))
+
+	// Carol jumps from last to first, and the others move down a place.
+	updates <- map[string]int{"alice": 30, "bob": 20, "carol": 40}
+
+	waitForStrings(t, tm, row(1, "carol", 40, "▲2"), row(2, "alice", 30, "▼1"), row(3, "bob", 20, "▼1"))
+
+	// Dave joins on the same score as bob, and is placed after him.
+	updates <- map[string]int{"alice": 30, "bob": 20, "carol": 40, "dave": 20}
+
+	waitForStrings(t, tm, row(3, "dave", 20, "NEW"))
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	var got []string
+	for _, e := range fm.entries {
+		got = append(got, fmt.Sprintf("%d:%s@%d", e.rank, e.name, e.y))
+	}
+	want := "2:alice@1 3:bob@2 1:carol@0 3:dave@3"
+	if strings.Join(got, " ") != want {
+		t.Errorf("want %s, got %s", want, strings.Join(got, " "))
+	}
+}
+
+func TestSlide(t *testing.T) {
+	m := newModel(nil, time.Hour, time.Hour)
+	m.rerank(map[string]int{"alice": 30, "bob": 20, "carol": 10})
+	m.rerank(map[string]int{"alice": 30, "bob": 20, "carol": 40})
+
+	// Carol moves up a row per tick, rather than jumping straight to the
+	// top.
+	for _, want := range []int{2, 1, 0} {
+		if got := m.entries[2].y; got != want {
+			t.Fatalf("want carol at row %d, got %d", want, got)
+		}
+		updated, _ := m.Update(tickMsg{})
+		m = updated.(model)
+	}
+	if m.animating {
+		t.Error("expected animation to stop once every entry is in place")
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	gutterStyle        = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	currentGutterStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Bold(true)
+	currentLineStyle   = lipgloss.NewStyle().Background(lipgloss.Color("236"))
+	statusStyle        = lipgloss.NewStyle().Faint(true)
+)
+
+// gutterWidth is the width of the widest line number, so that every number
+// lines up on the right.
+func gutterWidth(lines int) int {
+	return len(strconv.Itoa(max(1, lines)))
+}
+
+type model struct {
+	name     string
+	lines    []string
+	current  int
+	viewport viewport.Model
+	ready    bool
+}
+
+func newModel(name, content string) model {
+	return model{name: name, lines: strings.Split(strings.TrimSuffix(content, "\n"), "\n")}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.moveTo(m.current - 1)
+		case "down", "j":
+			m.moveTo(m.current + 1)
+		case "pgup", "b":
+			m.moveTo(m.current - m.viewport.Height)
+		case "pgdown", "f", " ":
+			m.moveTo(m.current + m.viewport.Height)
+		case "g", "home":
+			m.moveTo(0)
+		case "G", "end":
+			m.moveTo(len(m.lines) - 1)
+		}
+		return m, nil
+	case tea.WindowSizeMsg:
+		if !m.ready {
+			m.viewport = viewport.New(msg.Width, msg.Height-1)
+			m.ready = true
+		} else {
+			m.viewport.Width = msg.Width
+			m.viewport.Height = msg.Height - 1
+		}
+		m.moveTo(m.current)
+		return m, nil
+	}
+	return m, nil
+}
+
+// moveTo makes the given line current, scrolling just enough to keep it in
+// view.
+func (m *model) moveTo(line int) {
+	m.current = max(0, min(len(m.lines)-1, line))
+	if !m.ready {
+		return
+	}
+	m.render()
+	switch {
+	case m.current < m.viewport.YOffset:
+		m.viewport.SetYOffset(m.current)
+	case m.current >= m.viewport.YOffset+m.viewport.Height:
+		m.viewport.SetYOffset(m.current - m.viewport.Height + 1)
+	}
+}
+
+// render numbers every line. The gutter is part of each line rather than
+// a separate column, so it scrolls along with the content and can't fall
+// out of step with it.
+func (m *model) render() {
+	width := gutterWidth(len(m.lines))
+	var b strings.Builder
+	for i, line := range m.lines {
+		gutter := fmt.Sprintf("%*d │ ", width, i+1)
+		if i == m.current {
+			b.WriteString(currentGutterStyle.Render(gutter))
+			// Highlight the rest of the row, not just the text.
+			b.WriteString(currentLineStyle.Width(max(0, m.viewport.Width-width-3)).Render(line))
+		} else {
+			b.WriteString(gutterStyle.Render(gutter) + line)
+		}
+		if i < len(m.lines)-1 {
+			b.WriteString("\n")
+		}
+	}
+	m.viewport.SetContent(b.String())
+}
+
+func (m model) View() string {
+	if !m.ready {
+		return "loading..."
+	}
+	status := fmt.Sprintf("%s • line %d of %d • j/k: move • g/G: top/bottom • q: quit", m.name, m.current+1, len(m.lines))
+	return m.viewport.View() + "\n" + statusStyle.Render(status)
+}
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Println("usage: line-numbers <file>")
+		os.Exit(1)
+	}
+	content, err := os.ReadFile(os.Args[1])
+	if err != nil {
+		fmt.Println("could not read file:", err)
+		os.Exit(1)
+	}
+	p := tea.NewProgram(newModel(os.Args[1], string(content)), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestLineNumbers(t *testing.T) {
+	var lines []string
+	for i := 1; i <= 12; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	m := newModel("buffer.txt", strings.Join(lines, "\n")+"\n")
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(40, 6))
+
+	// Twelve lines need a gutter two wide, so single digits are padded.
+	waitForStrings(t, tm, " 1 │ line 1", " 2 │ line 2", " 5 │ line 5", "line 1 of 12")
+
+	// Scroll to the bottom: the gutter still lines up.
+	tm.Type("G")
+
+	waitForStrings(t, tm, " 8 │ line 8", " 9 │ line 9", "10 │ line 10", "12 │ line 12", "line 12 of 12")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.viewport.YOffset != 7 {
+		t.Errorf("expected viewport to scroll to the last lines, got offset %d", fm.viewport.YOffset)
+	}
+}
+
+func TestThousandsOfLines(t *testing.T) {
+	lines := make([]string, 1200)
+	for i := range lines {
+		lines[i] = "x"
+	}
+	tm := teatest.NewTestModel(t, newModel("big.txt", strings.Join(lines, "\n")), teatest.WithInitialTermSize(40, 6))
+
+	waitForStrings(t, tm, "   1 │ x", "   5 │ x")
+
+	tm.Type("G")
+
+	waitForStrings(t, tm, "1196 │ x", "1200 │ x")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestSingleLine(t *testing.T) {
+	// No trailing newline, so no empty second line either.
+	tm := teatest.NewTestModel(t, newModel("one.txt", "only line"), teatest.WithInitialTermSize(40, 6))
+
+	waitForStrings(t, tm, "1 │ only line", "line 1 of 1")
+
+	tm.Type("jq")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.current != 0 {
+		t.Errorf("expected to stay on the only line, got %d", fm.current)
+	}
+}
+
+func TestGutterWidth(t *testing.T) {
+	for lines, want := range map[int]int{0: 1, 1: 1, 9: 1, 10: 2, 999: 3, 1000: 4, 12345: 5} {
+		if got := gutterWidth(lines); got != want {
+			t.Errorf("%d lines: want width %d, got %d", lines, want, got)
+		}
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
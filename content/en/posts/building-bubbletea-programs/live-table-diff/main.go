@@ -0,0 +1,305 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// levels is the number of steps a highlight fades through, one every
+	// fadeInterval.
+	levels       = 3
+	fadeInterval = 400 * time.Millisecond
+)
+
+var (
+	headerStyle = lipgloss.NewStyle().Bold(true)
+	// The highlight for each level, brightest last.
+	upStyles = []lipgloss.Style{
+		lipgloss.NewStyle().Foreground(lipgloss.Color("22")),
+		lipgloss.NewStyle().Foreground(lipgloss.Color("28")),
+		lipgloss.NewStyle().Foreground(lipgloss.Color("46")).Bold(true),
+	}
+	downStyles = []lipgloss.Style{
+		lipgloss.NewStyle().Foreground(lipgloss.Color("52")),
+		lipgloss.NewStyle().Foreground(lipgloss.Color("124")),
+		lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true),
+	}
+	addedStyles = []lipgloss.Style{
+		lipgloss.NewStyle().Foreground(lipgloss.Color("24")),
+		lipgloss.NewStyle().Foreground(lipgloss.Color("32")),
+		lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Bold(true),
+	}
+	removedStyle = lipgloss.NewStyle().Faint(true).Strikethrough(true)
+)
+
+// row is a row from the data source. Rows are matched between polls by
+// their key, not their position, which can change.
+type row struct {
+	key   string
+	value float64
+}
+
+type change int
+
+const (
+	unchanged change = iota
+	up
+	down
+	added
+	removed
+)
+
+// displayRow is a row as shown, along with how it changed in the last poll
+// and how far its highlight has faded.
+type displayRow struct {
+	row
+	change change
+	level  int
+}
+
+type (
+	pollMsg struct{}
+	rowsMsg []row
+	errMsg  struct{ err error }
+	fadeMsg struct{}
+)
+
+type model struct {
+	source   func() ([]row, error)
+	interval time.Duration
+	rows     []displayRow
+	err      error
+	// fading is true while a fade tick is scheduled.
+	fading bool
+}
+
+func newModel(source func() ([]row, error), interval time.Duration) model {
+	return model{source: source, interval: interval}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.poll()
+}
+
+func (m model) poll() tea.Cmd {
+	return func() tea.Msg {
+		rows, err := m.source()
+		if err != nil {
+			return errMsg{err}
+		}
+		return rowsMsg(rows)
+	}
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case pollMsg:
+		return m, m.poll()
+	case rowsMsg:
+		m.err = nil
+		m.diff(msg)
+		return m, tea.Batch(m.schedulePoll(), m.fade())
+	case errMsg:
+		// Keep showing the last rows, and try again next time.
+		m.err = msg.err
+		return m, m.schedulePoll()
+	case fadeMsg:
+		m.fading = false
+		rows := m.rows[:0]
+		for _, r := range m.rows {
+			r.level = max(0, r.level-1)
+			if r.level == 0 {
+				if r.change == removed {
+					continue
+				}
+				r.change = unchanged
+			}
+			rows = append(rows, r)
+		}
+		m.rows = rows
+		return m, m.fade()
+	}
+	return m, nil
+}
+
+func (m model) schedulePoll() tea.Cmd {
+	return tea.Tick(m.interval, func(time.Time) tea.Msg {
+		return pollMsg{}
+	})
+}
+
+// fade schedules the next step of fading, unless there's nothing to fade or
+// it's already scheduled.
+func (m *model) fade() tea.Cmd {
+	if m.fading {
+		return nil
+	}
+	for _, r := range m.rows {
+		if r.level > 0 {
+			m.fading = true
+			return tea.Tick(fadeInterval, func(time.Time) tea.Msg {
+				return fadeMsg{}
+			})
+		}
+	}
+	return nil
+}
+
+// diff replaces the rows with the latest poll, highlighting what changed.
+// Rows that have gone are kept where they were until they've faded out.
+func (m *model) diff(latest []row) {
+	previous := make(map[string]displayRow, len(m.rows))
+	for _, r := range m.rows {
+		previous[r.key] = r
+	}
+	current := make(map[string]bool, len(latest))
+	rows := make([]displayRow, 0, len(latest))
+	for _, r := range latest {
+		current[r.key] = true
+		old, ok := previous[r.key]
+		switch {
+		case !ok || old.change == removed:
+			// The first poll isn't highlighted: everything is new.
+			if m.rows != nil {
+				rows = append(rows, displayRow{row: r, change: added, level: levels})
+			} else {
+				rows = append(rows, displayRow{row: r})
+			}
+		case r.value > old.value:
+			rows = append(rows, displayRow{row: r, change: up, level: levels})
+		case r.value < old.value:
+			rows = append(rows, displayRow{row: r, change: down, level: levels})
+		default:
+			// Unchanged, so carry on fading any earlier highlight.
+			old.row = r
+			rows = append(rows, old)
+		}
+	}
+	for i, r := range m.rows {
+		if current[r.key] {
+			continue
+		}
+		if r.change != removed {
+			r.change, r.level = removed, levels
+		}
+		// Insert it after the row it came after before, if that's still
+		// shown.
+		at := 0
+		if i > 0 {
+			at = indexOf(rows, m.rows[i-1].key) + 1
+		}
+		rows = append(rows[:at], append([]displayRow{r}, rows[at:]...)...)
+	}
+	m.rows = rows
+}
+
+// indexOf returns the index of the row with the key, or -1.
+func indexOf(rows []displayRow, key string) int {
+	for i, r := range rows {
+		if r.key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	b.WriteString(headerStyle.Render(fmt.Sprintf("%-8s %10s", "SYMBOL", "PRICE")) + "\n")
+	for _, r := range m.rows {
+		b.WriteString(rowView(r) + "\n")
+	}
+	b.WriteString("\n")
+	if m.err != nil {
+		b.WriteString("error: " + m.err.Error() + "\n")
+	}
+	b.WriteString("q: quit\n")
+	return b.String()
+}
+
+func rowView(r displayRow) string {
+	arrow := " "
+	switch r.change {
+	case up:
+		arrow = "▲"
+	case down:
+		arrow = "▼"
+	case added:
+		arrow = "+"
+	case removed:
+		arrow = "-"
+	}
+	line := fmt.Sprintf("%-8s %10.2f %s", r.key, r.value, arrow)
+	switch {
+	case r.change == removed:
+		return removedStyle.Render(line)
+	case r.level == 0:
+		return line
+	case r.change == up:
+		return upStyles[r.level-1].Render(line)
+	case r.change == down:
+		return downStyles[r.level-1].Render(line)
+	case r.change == added:
+		return addedStyles[r.level-1].Render(line)
+	}
+	return line
+}
+
+// market is a data source of prices that wander randomly, with symbols
+// occasionally listed and delisted.
+type market struct {
+	mu     sync.Mutex
+	prices map[string]float64
+	order  []string
+}
+
+func (mk *market) rows() ([]row, error) {
+	mk.mu.Lock()
+	defer mk.mu.Unlock()
+	for _, sym := range mk.order {
+		if rand.Intn(3) == 0 {
+			mk.prices[sym] += float64(rand.Intn(200)-100) / 100
+		}
+	}
+	if rand.Intn(5) == 0 && len(mk.order) > 3 {
+		i := rand.Intn(len(mk.order))
+		delete(mk.prices, mk.order[i])
+		mk.order = append(mk.order[:i], mk.order[i+1:]...)
+	} else if rand.Intn(5) == 0 {
+		sym := fmt.Sprintf("NEW%d", rand.Intn(100))
+		if _, ok := mk.prices[sym]; !ok {
+			mk.order = append(mk.order, sym)
+			mk.prices[sym] = 10
+		}
+	}
+	rows := make([]row, len(mk.order))
+	for i, sym := range mk.order {
+		rows[i] = row{key: sym, value: mk.prices[sym]}
+	}
+	return rows, nil
+}
+
+func main() {
+	mk := &market{
+		prices: map[string]float64{"AAPL": 226.05, "GOOG": 165.39, "MSFT": 416.79, "AMZN": 177.04, "NVDA": 128.30},
+		order:  []string{"AAPL", "GOOG", "MSFT", "AMZN", "NVDA"},
+	}
+	p := tea.NewProgram(newModel(mk.rows, 2*time.Second))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/muesli/termenv"
+)
+
+// fakeSource returns whatever rows it was last given.
+type fakeSource struct {
+	mu   sync.Mutex
+	rows []row
+}
+
+func (s *fakeSource) Set(rows ...row) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rows = rows
+}
+
+func (s *fakeSource) Rows() ([]row, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]row(nil), s.rows...), nil
+}
+
+func TestHighlightChanged(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() { lipgloss.SetColorProfile(profile) })
+
+	src := &fakeSource{}
+	src.Set(row{"AAPL", 226.05}, row{"GOOG", 165.39}, row{"MSFT", 416.79})
+	tm := teatest.NewTestModel(t, newModel(src.Rows, 50*time.Millisecond), teatest.WithInitialTermSize(80, 20))
+
+	waitForString(t, tm, "MSFT         416.79")
+
+	src.Set(row{"AAPL", 226.05}, row{"GOOG", 170.00}, row{"MSFT", 400.00})
+
+	waitForStrings(t, tm,
+		upStyles[levels-1].Render("GOOG         170.00 ▲"),
+		downStyles[levels-1].Render("MSFT         400.00 ▼"),
+	)
+
+	// Wait for the highlights to fade.
+	time.Sleep(levels*fadeInterval + 200*time.Millisecond)
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	for _, r := range fm.rows {
+		if r.change != unchanged || r.level != 0 {
+			t.Errorf("%s: expected highlight to have faded, got change %d at level %d", r.key, r.change, r.level)
+		}
+	}
+}
+
+func TestAddRemove(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() { lipgloss.SetColorProfile(profile) })
+
+	src := &fakeSource{}
+	src.Set(row{"AAPL", 226.05}, row{"GOOG", 165.39}, row{"MSFT", 416.79})
+	tm := teatest.NewTestModel(t, newModel(src.Rows, 50*time.Millisecond), teatest.WithInitialTermSize(80, 20))
+
+	waitForString(t, tm, "MSFT         416.79")
+
+	// GOOG is removed, and NVDA is added at the front, so every row that
+	// remains moves: they must be matched by key, not position.
+	src.Set(row{"NVDA", 128.30}, row{"AAPL", 226.05}, row{"MSFT", 416.79})
+
+	waitForString(t, tm, addedStyles[levels-1].Render("NVDA         128.30 +"))
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	var keys []string
+	for _, r := range fm.rows {
+		keys = append(keys, r.key)
+		if r.key == "AAPL" || r.key == "MSFT" {
+			if r.change != unchanged {
+				t.Errorf("%s: expected no change, got %d", r.key, r.change)
+			}
+		}
+	}
+	// The removed row stays where it was until it has faded out.
+	if got := strings.Join(keys, ","); got != "NVDA,AAPL,GOOG,MSFT" && got != "NVDA,AAPL,MSFT" {
+		t.Errorf("unexpected rows: %s", got)
+	}
+}
+
+func TestRemovedFadesOut(t *testing.T) {
+	src := &fakeSource{}
+	src.Set(row{"AAPL", 226.05}, row{"GOOG", 165.39})
+	tm := teatest.NewTestModel(t, newModel(src.Rows, 50*time.Millisecond), teatest.WithInitialTermSize(80, 20))
+
+	waitForString(t, tm, "GOOG         165.39")
+
+	src.Set(row{"AAPL", 226.05})
+
+	waitForString(t, tm, "GOOG         165.39 -")
+
+	// Wait for it to fade out completely.
+	time.Sleep(levels*fadeInterval + 200*time.Millisecond)
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if len(fm.rows) != 1 || fm.rows[0].key != "AAPL" {
+		t.Errorf("expected only AAPL to remain, got %+v", fm.rows)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	waitForStrings(t, tm, s)
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type tickMsg struct{}
+
+type doneMsg struct{ content string }
+
+type model struct {
+	interval time.Duration
+	work     tea.Cmd
+	frame    int
+	done     bool
+	content  string
+}
+
+func newModel(interval time.Duration, work tea.Cmd) model {
+	return model{interval: interval, work: work}
+}
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.tick(), m.work)
+}
+
+func (m model) tick() tea.Cmd {
+	return tea.Tick(m.interval, func(time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case tickMsg:
+		if m.done {
+			// The work finished between ticks, and this tick was already
+			// scheduled. Drop it, and don't schedule another, which ends
+			// the animation.
+			return m, nil
+		}
+		m.frame++
+		return m, m.tick()
+	case doneMsg:
+		m.done = true
+		m.content = msg.content
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.done {
+		return m.content + "\n"
+	}
+	// Pad the dots to a fixed width so nothing after them shifts about.
+	dots := strings.Repeat(".", m.frame%3+1)
+	return fmt.Sprintf("Loading%-3s\n", dots)
+}
+
+func work() tea.Msg {
+	time.Sleep(3 * time.Second)
+	return doneMsg{content: "Loaded 42 records."}
+}
+
+func main() {
+	p := tea.NewProgram(newModel(300*time.Millisecond, work))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestDots(t *testing.T) {
+	// The work never finishes by itself; the test sends doneMsg instead.
+	m := newModel(50*time.Millisecond, nil)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "Loading.  ")
+	waitForString(t, tm, "Loading.. ")
+	waitForString(t, tm, "Loading...")
+
+	tm.Send(doneMsg{content: "Loaded 3 records."})
+
+	waitForString(t, tm, "Loaded 3 records.")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	if strings.Contains(fm.View(), "Loading") {
+		t.Errorf("expected content to replace the dots, got %q", fm.View())
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,121 @@
+// Package logview provides a scrollable log component.
+package logview
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Level is the severity of a log entry.
+type Level int
+
+const (
+	Debug Level = iota
+	Info
+	Warn
+	Error
+)
+
+var levelStyles = map[Level]lipgloss.Style{
+	Debug: lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
+	Info:  lipgloss.NewStyle().Foreground(lipgloss.Color("12")),
+	Warn:  lipgloss.NewStyle().Foreground(lipgloss.Color("214")),
+	Error: lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Bold(true),
+}
+
+var levelNames = map[Level]string{
+	Debug: "DEBUG",
+	Info:  "INFO ",
+	Warn:  "WARN ",
+	Error: "ERROR",
+}
+
+// Entry is a single log line.
+type Entry struct {
+	Time    time.Time
+	Level   Level
+	Message string
+}
+
+func (e Entry) render() string {
+	return e.Time.Format(time.TimeOnly) + " " + levelStyles[e.Level].Render(levelNames[e.Level]) + " " + e.Message
+}
+
+// Model is a log that sticks to the newest entry unless the user has
+// scrolled up, and drops the oldest entries beyond a maximum.
+type Model struct {
+	entries  []Entry
+	limit    int
+	viewport viewport.Model
+}
+
+// New returns a log that keeps at most limit entries.
+func New(limit int) Model {
+	return Model{limit: limit, viewport: viewport.New(0, 0)}
+}
+
+// SetSize sets the dimensions of the log.
+func (m *Model) SetSize(width, height int) {
+	m.viewport.Width = width
+	m.viewport.Height = height
+	m.render()
+	m.viewport.SetYOffset(m.viewport.YOffset)
+}
+
+// Len returns the number of entries in the log.
+func (m Model) Len() int {
+	return len(m.entries)
+}
+
+// Append adds an entry to the log, dropping the oldest entry if the log is
+// full.
+func (m *Model) Append(e Entry) {
+	following := m.viewport.AtBottom()
+	m.entries = append(m.entries, e)
+	var dropped int
+	if len(m.entries) > m.limit {
+		dropped = len(m.entries) - m.limit
+		m.entries = m.entries[dropped:]
+	}
+	m.render()
+	if following {
+		m.viewport.GotoBottom()
+	} else {
+		// Keep the same entries in view: the ones above them have moved
+		// up by however many were dropped.
+		m.viewport.SetYOffset(m.viewport.YOffset - dropped)
+	}
+}
+
+func (m *Model) render() {
+	lines := make([]string, len(m.entries))
+	for i, e := range m.entries {
+		lines[i] = e.render()
+	}
+	m.viewport.SetContent(strings.Join(lines, "\n"))
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "home", "g":
+			m.viewport.GotoTop()
+			return m, nil
+		case "end", "G":
+			m.viewport.GotoBottom()
+			return m, nil
+		}
+	}
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+func (m Model) View() string {
+	return m.viewport.View()
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"unordered/logview"
+)
+
+var statusStyle = lipgloss.NewStyle().Faint(true)
+
+type entryMsg logview.Entry
+
+type model struct {
+	logs     logview.Model
+	interval time.Duration
+}
+
+func newModel(limit int, interval time.Duration) model {
+	return model{logs: logview.New(limit), interval: interval}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.next()
+}
+
+// next simulates a log entry arriving after the interval.
+func (m model) next() tea.Cmd {
+	return tea.Tick(m.interval, func(t time.Time) tea.Msg {
+		messages := []string{"request handled", "cache miss", "retrying connection", "worker started", "disk usage high"}
+		return entryMsg{
+			Time:    t,
+			Level:   logview.Level(rand.Intn(4)),
+			Message: messages[rand.Intn(len(messages))],
+		}
+	})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case tea.WindowSizeMsg:
+		m.logs.SetSize(msg.Width, msg.Height-1)
+		return m, nil
+	case entryMsg:
+		m.logs.Append(logview.Entry(msg))
+		return m, m.next()
+	}
+	var cmd tea.Cmd
+	m.logs, cmd = m.logs.Update(msg)
+	return m, cmd
+}
+
+func (m model) View() string {
+	return m.logs.View() + "\n" + statusStyle.Render(fmt.Sprintf("%d entries • ↑/↓: scroll • end: follow • q: quit", m.logs.Len()))
+}
+
+func main() {
+	p := tea.NewProgram(newModel(1000, 100*time.Millisecond), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
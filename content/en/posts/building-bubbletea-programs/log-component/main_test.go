@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+
+	"unordered/logview"
+)
+
+func entry(n int) entryMsg {
+	return entryMsg{
+		Time:    time.Date(2024, 8, 25, 12, 0, 0, 0, time.UTC),
+		Level:   logview.Info,
+		Message: fmt.Sprintf("line %d", n),
+	}
+}
+
+func TestCap(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(5, time.Hour), teatest.WithInitialTermSize(80, 24))
+
+	for i := 1; i <= 8; i++ {
+		tm.Send(entry(i))
+	}
+
+	waitForString(t, tm, "5 entries")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	view := fm.View()
+	for i := 1; i <= 3; i++ {
+		if strings.Contains(view, fmt.Sprintf("line %d", i)) {
+			t.Errorf("expected line %d to have been dropped", i)
+		}
+	}
+	for i := 4; i <= 8; i++ {
+		if !strings.Contains(view, fmt.Sprintf("line %d", i)) {
+			t.Errorf("expected line %d to remain", i)
+		}
+	}
+}
+
+func TestAppendWhileScrolledUp(t *testing.T) {
+	// Leaves room for three lines of logs above the status line.
+	tm := teatest.NewTestModel(t, newModel(5, time.Hour), teatest.WithInitialTermSize(80, 4))
+
+	for i := 1; i <= 5; i++ {
+		tm.Send(entry(i))
+	}
+
+	waitForString(t, tm, "5 entries")
+
+	// Scroll up one line, so lines 2-4 are in view.
+	tm.Send(tea.KeyMsg{Type: tea.KeyUp})
+
+	// Appending drops line 1, but lines 2-4 should stay in view.
+	tm.Send(entry(6))
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	lines := strings.Split(fm.logs.View(), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d", len(lines))
+	}
+	for i, line := range lines {
+		if want := fmt.Sprintf("line %d", i+2); !strings.Contains(line, want) {
+			t.Errorf("expected line %q to contain %q", line, want)
+		}
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
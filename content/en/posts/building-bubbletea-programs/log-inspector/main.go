@@ -0,0 +1,227 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
+)
+
+var (
+	cursorStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	dividerStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	keyStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("39"))
+	errorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	helpStyle    = lipgloss.NewStyle().Faint(true)
+)
+
+type field struct {
+	key, value string
+}
+
+// wellKnown are the fields listed first, in this order, before the rest in
+// alphabetical order.
+var wellKnown = []string{"time", "level", "msg"}
+
+// parse extracts the fields from a line, which can be either JSON or
+// logfmt.
+func parse(line string) ([]field, error) {
+	var fields []field
+	if strings.HasPrefix(strings.TrimSpace(line), "{") {
+		dec := json.NewDecoder(strings.NewReader(line))
+		dec.UseNumber()
+		var obj map[string]any
+		if err := dec.Decode(&obj); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+		fields = flatten("", obj, nil)
+	} else {
+		var err error
+		if fields, err = parseLogfmt(line); err != nil {
+			return nil, err
+		}
+	}
+	slices.SortFunc(fields, func(a, b field) int {
+		ra, rb := rank(a.key), rank(b.key)
+		if ra != rb {
+			return ra - rb
+		}
+		return strings.Compare(a.key, b.key)
+	})
+	return fields, nil
+}
+
+func rank(key string) int {
+	if i := slices.Index(wellKnown, key); i >= 0 {
+		return i
+	}
+	return len(wellKnown)
+}
+
+// flatten turns nested objects into fields with dotted keys, so that
+// {"req": {"method": "GET"}} becomes req.method=GET. Arrays are left as
+// JSON.
+func flatten(prefix string, obj map[string]any, fields []field) []field {
+	for k, v := range obj {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		switch v := v.(type) {
+		case map[string]any:
+			fields = flatten(key, v, fields)
+		case string:
+			fields = append(fields, field{key: key, value: v})
+		default:
+			var b bytes.Buffer
+			enc := json.NewEncoder(&b)
+			enc.SetEscapeHTML(false)
+			enc.Encode(v)
+			fields = append(fields, field{key: key, value: strings.TrimSpace(b.String())})
+		}
+	}
+	return fields
+}
+
+// parseLogfmt parses key=value pairs separated by spaces, where values
+// containing spaces are quoted. Every word must be a pair, otherwise it's
+// just text.
+func parseLogfmt(line string) ([]field, error) {
+	var fields []field
+	rest := strings.TrimSpace(line)
+	for rest != "" {
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 || strings.ContainsAny(rest[:eq], " \"") {
+			return nil, errors.New("not a key=value pair: " + strings.Fields(rest)[0])
+		}
+		key := rest[:eq]
+		rest = rest[eq+1:]
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			quoted, err := strconv.QuotedPrefix(rest)
+			if err != nil {
+				return nil, fmt.Errorf("unterminated quote in %s", key)
+			}
+			value, _ = strconv.Unquote(quoted)
+			rest = rest[len(quoted):]
+		} else if end := strings.IndexByte(rest, ' '); end >= 0 {
+			value, rest = rest[:end], rest[end:]
+		} else {
+			value, rest = rest, ""
+		}
+		fields = append(fields, field{key: key, value: value})
+		rest = strings.TrimLeft(rest, " ")
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("empty line")
+	}
+	return fields, nil
+}
+
+type model struct {
+	lines         []string
+	cursor        int
+	offset        int
+	width, height int
+}
+
+func newModel(lines []string) model {
+	return model{lines: lines, width: 80, height: 24}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+// listHeight is the height of the top pane; the detail pane gets the rest.
+func (m model) listHeight() int {
+	return max(1, (m.height-2)/2)
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = max(0, min(len(m.lines)-1, m.cursor+1))
+		case "g":
+			m.cursor = 0
+		case "G":
+			m.cursor = max(0, len(m.lines)-1)
+		}
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+	}
+	// Scroll just enough to keep the cursor in the top pane.
+	m.offset = max(min(m.offset, m.cursor), m.cursor-m.listHeight()+1)
+	return m, nil
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	end := min(len(m.lines), m.offset+m.listHeight())
+	for i := m.offset; i < end; i++ {
+		line := truncate.StringWithTail(m.lines[i], uint(max(0, m.width-2)), "…")
+		if i == m.cursor {
+			b.WriteString(cursorStyle.Render("> "+line) + "\n")
+		} else {
+			b.WriteString("  " + line + "\n")
+		}
+	}
+	for i := end; i < m.offset+m.listHeight(); i++ {
+		b.WriteString("\n")
+	}
+	b.WriteString(dividerStyle.Render(strings.Repeat("─", m.width)) + "\n")
+	if len(m.lines) > 0 {
+		b.WriteString(m.detail())
+	}
+	b.WriteString(helpStyle.Render("j/k: select • g/G: first/last • q: quit") + "\n")
+	return b.String()
+}
+
+func (m model) detail() string {
+	line := m.lines[m.cursor]
+	fields, err := parse(line)
+	if err != nil {
+		// Show the line as it is, so nothing is lost.
+		return errorStyle.Render("unparseable line: "+err.Error()) + "\n" + line + "\n"
+	}
+	width := 0
+	for _, f := range fields {
+		width = max(width, len(f.key))
+	}
+	var b strings.Builder
+	for _, f := range fields {
+		b.WriteString(keyStyle.Render(fmt.Sprintf("%-*s", width, f.key)) + "  " + f.value + "\n")
+	}
+	return b.String()
+}
+
+func main() {
+	lines := []string{
+		`{"time":"2024-08-25T12:00:01Z","level":"info","msg":"server started","addr":":8080"}`,
+		`time=2024-08-25T12:00:02Z level=debug msg="loading config" path=/etc/app/config.yaml`,
+		`{"time":"2024-08-25T12:00:03Z","level":"info","msg":"request","req":{"method":"GET","path":"/api/users","headers":{"accept":"application/json"}},"status":200,"duration_ms":12.5}`,
+		`time=2024-08-25T12:00:04Z level=warn msg="slow query" table=users duration=1.2s`,
+		`panic: runtime error: invalid memory address or nil pointer dereference`,
+		`{"time":"2024-08-25T12:00:05Z","level":"error","msg":"request failed","err":"connection reset","tags":["db","retry"]}`,
+		`{"time":"2024-08-25T12:00:06Z","level":"info","msg":"trunc`,
+	}
+	p := tea.NewProgram(newModel(lines), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+var lines = []string{
+	`time=2024-08-25T12:00:00Z level=warn msg="slow query" table=users`,
+	`{"time":"2024-08-25T12:00:01Z","level":"info","msg":"request","req":{"method":"GET","path":"/users"},"status":200}`,
+	`panic: something went wrong`,
+}
+
+func TestInspect(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(lines), teatest.WithInitialTermSize(120, 24))
+
+	waitForStrings(t, tm,
+		"time   2024-08-25T12:00:00Z",
+		"level  warn",
+		"msg    slow query",
+		"table  users",
+	)
+
+	// The nested request fields are flattened into dotted keys.
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+
+	waitForStrings(t, tm,
+		"time        2024-08-25T12:00:01Z",
+		"level       info",
+		"msg         request",
+		"req.method  GET",
+		"req.path    /users",
+		"status      200",
+	)
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+
+	waitForStrings(t, tm, "unparseable line: not a key=value pair: panic:")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		line string
+		want string
+	}{
+		{`a=1 b="two words" c=`, `a=1 b=two words c=`},
+		{`msg="say \"hi\"" level=info`, `level=info msg=say "hi"`},
+		{`{"level":"info","n":{"x":1,"y":[1,2]}}`, `level=info n.x=1 n.y=[1,2]`},
+	}
+	for _, tt := range tests {
+		fields, err := parse(tt.line)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.line, err)
+			continue
+		}
+		var got []string
+		for _, f := range fields {
+			got = append(got, f.key+"="+f.value)
+		}
+		if strings.Join(got, " ") != tt.want {
+			t.Errorf("%s: want %s, got %s", tt.line, tt.want, strings.Join(got, " "))
+		}
+	}
+	for _, line := range []string{"", "just some text", `msg="unterminated`, `{"level":`} {
+		if _, err := parse(line); err == nil {
+			t.Errorf("%q: expected an error", line)
+		}
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
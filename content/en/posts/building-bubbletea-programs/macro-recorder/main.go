@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const cells = 12
+
+var (
+	cursorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	recordingStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Bold(true)
+	helpStyle      = lipgloss.NewStyle().Faint(true)
+)
+
+type model struct {
+	cells  [cells]bool
+	cursor int
+
+	recording bool
+	macro     []tea.KeyMsg
+	flash     string
+}
+
+func newModel() model {
+	return model{}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		m.flash = ""
+		switch msg.String() {
+		case "q":
+			if m.recording {
+				// The stop key isn't part of the macro: it was never
+				// appended.
+				m.recording = false
+				return m, nil
+			}
+			m.recording = true
+			m.macro = nil
+			return m, nil
+		case "@":
+			if m.recording {
+				// Replaying a macro while recording it would record the
+				// replay, and replaying that would never end.
+				m.flash = "can't replay while recording"
+				return m, nil
+			}
+			return m.replay()
+		}
+		if m.recording {
+			m.macro = append(m.macro, msg)
+		}
+		return m.handleKey(msg), nil
+	}
+	return m, nil
+}
+
+// replay sends each key in the macro back through Update, in order.
+func (m model) replay() (tea.Model, tea.Cmd) {
+	if len(m.macro) == 0 {
+		m.flash = "nothing recorded: press q to record"
+		return m, nil
+	}
+	var (
+		cmds []tea.Cmd
+		next tea.Model = m
+	)
+	for _, k := range m.macro {
+		var cmd tea.Cmd
+		next, cmd = next.Update(k)
+		cmds = append(cmds, cmd)
+	}
+	return next, tea.Batch(cmds...)
+}
+
+// handleKey handles the keys that can be recorded.
+func (m model) handleKey(msg tea.KeyMsg) model {
+	switch msg.String() {
+	case "left", "h":
+		m.cursor = max(0, m.cursor-1)
+	case "right", "l":
+		m.cursor = min(cells-1, m.cursor+1)
+	case " ":
+		m.cells[m.cursor] = !m.cells[m.cursor]
+	}
+	return m
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	for i, on := range m.cells {
+		cell := "[ ]"
+		if on {
+			cell = "[x]"
+		}
+		if i == m.cursor {
+			cell = cursorStyle.Render(cell)
+		}
+		b.WriteString(cell)
+	}
+	b.WriteString("\n\n")
+
+	keys := make([]string, len(m.macro))
+	for i, k := range m.macro {
+		keys[i] = k.String()
+		if keys[i] == " " {
+			keys[i] = "space"
+		}
+	}
+	b.WriteString("macro: " + strings.Join(keys, " "))
+	if m.recording {
+		b.WriteString(" " + recordingStyle.Render("● recording"))
+	}
+	b.WriteString("\n")
+	if m.flash != "" {
+		b.WriteString(m.flash + "\n")
+	}
+	b.WriteString(helpStyle.Render("h/l: move • space: toggle • q: record • @: replay • ctrl+c: quit") + "\n")
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
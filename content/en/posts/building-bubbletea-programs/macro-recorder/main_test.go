@@ -0,0 +1,69 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestReplay(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(), teatest.WithInitialTermSize(80, 20))
+
+	// Record moving right and toggling the cell under the cursor.
+	tm.Type("q")
+	tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+	tm.Type(" q")
+
+	waitForString(t, tm, "macro: right space\r\n")
+
+	tm.Type("@@")
+
+	waitForString(t, tm, "[ ][x][x][x][ ]")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.cursor != 3 {
+		t.Errorf("expected cursor on cell 3, got %d", fm.cursor)
+	}
+	// The stop key isn't recorded.
+	if len(fm.macro) != 2 {
+		t.Errorf("expected two recorded keys, got %d", len(fm.macro))
+	}
+}
+
+func TestReplayEmpty(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(), teatest.WithInitialTermSize(80, 20))
+
+	tm.Type("@")
+
+	waitForString(t, tm, "nothing recorded")
+
+	// Recording nothing leaves nothing to replay.
+	tm.Type("qq@")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.flash != "nothing recorded: press q to record" {
+		t.Errorf("unexpected flash: %q", fm.flash)
+	}
+	if fm.recording {
+		t.Error("expected recording to have stopped")
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
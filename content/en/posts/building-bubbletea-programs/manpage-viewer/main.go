@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const sidebarWidth = 24
+
+var (
+	sidebarStyle = lipgloss.NewStyle().
+			Width(sidebarWidth).
+			Border(lipgloss.NormalBorder(), false, true, false, false).
+			PaddingRight(1)
+	headingStyle  = lipgloss.NewStyle().Bold(true)
+	selectedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	statusStyle   = lipgloss.NewStyle().Faint(true)
+)
+
+type section struct {
+	title, body string
+}
+
+type model struct {
+	sections []section
+	// headings is the line of the content on which each section starts.
+	headings []int
+	viewport viewport.Model
+	ready    bool
+	height   int
+
+	// cursor is the selected entry of the table of contents, and offset
+	// is the first entry shown, for when they don't all fit.
+	cursor, offset int
+}
+
+func newModel(sections []section) model {
+	return model{sections: sections}
+}
+
+// render builds the document, recording where each section starts.
+func (m *model) render() string {
+	var lines []string
+	m.headings = make([]int, len(m.sections))
+	for i, s := range m.sections {
+		m.headings[i] = len(lines)
+		lines = append(lines, headingStyle.Render(s.title))
+		lines = append(lines, strings.Split(s.body, "\n")...)
+		lines = append(lines, "")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+			m.scrollSidebar()
+			return m, nil
+		case "down", "j":
+			m.cursor = max(0, min(len(m.sections)-1, m.cursor+1))
+			m.scrollSidebar()
+			return m, nil
+		case "enter":
+			// The headings aren't known until the document has been
+			// rendered at the first size, and a page may have no sections.
+			if !m.ready || m.cursor >= len(m.headings) {
+				return m, nil
+			}
+			// The viewport won't scroll past the end of the document, so
+			// a section near the end is shown lower down instead of at
+			// the top.
+			m.viewport.SetYOffset(m.headings[m.cursor])
+			return m, nil
+		}
+	case tea.WindowSizeMsg:
+		width := max(0, msg.Width-sidebarWidth-1)
+		if !m.ready {
+			m.viewport = viewport.New(width, msg.Height-1)
+			m.viewport.SetContent(m.render())
+			m.ready = true
+		} else {
+			m.viewport.Width = width
+			m.viewport.Height = msg.Height - 1
+		}
+		m.height = msg.Height - 1
+		m.scrollSidebar()
+		return m, nil
+	}
+	// Pass on anything else, such as page up and down, to scroll the
+	// document.
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// scrollSidebar scrolls the table of contents just enough to keep the
+// cursor in view.
+func (m *model) scrollSidebar() {
+	if m.height <= 0 {
+		return
+	}
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	} else if m.cursor >= m.offset+m.height {
+		m.offset = m.cursor - m.height + 1
+	}
+}
+
+func (m model) sidebarView() string {
+	var lines []string
+	end := min(len(m.sections), m.offset+m.height)
+	for i := m.offset; i < end; i++ {
+		title := m.sections[i].title
+		if len(title) > sidebarWidth-3 {
+			title = title[:sidebarWidth-4] + "…"
+		}
+		if i == m.cursor {
+			lines = append(lines, selectedStyle.Render("> "+title))
+		} else {
+			lines = append(lines, "  "+title)
+		}
+	}
+	return sidebarStyle.Height(m.height).Render(strings.Join(lines, "\n"))
+}
+
+func (m model) View() string {
+	if !m.ready {
+		return "loading..."
+	}
+	main := lipgloss.JoinHorizontal(lipgloss.Top, m.sidebarView(), " ", m.viewport.View())
+	status := fmt.Sprintf("%3.f%% • j/k: select • enter: jump • pgup/pgdown: scroll • q: quit", m.viewport.ScrollPercent()*100)
+	return main + "\n" + statusStyle.Render(status)
+}
+
+func document() []section {
+	titles := []string{
+		"NAME", "SYNOPSIS", "DESCRIPTION", "OPTIONS", "COMMANDS", "CONFIGURATION",
+		"ENVIRONMENT", "FILES", "EXIT STATUS", "SIGNALS", "LOGGING", "SECURITY",
+		"PERFORMANCE", "COMPATIBILITY", "EXAMPLES", "TROUBLESHOOTING", "BUGS",
+		"AUTHORS", "COPYRIGHT", "SEE ALSO",
+	}
+	sections := make([]section, len(titles))
+	for i, title := range titles {
+		var body []string
+		for j := range 3 + (i*7)%10 {
+			body = append(body, fmt.Sprintf("    Paragraph %d of the %s section.", j+1, strings.ToLower(title)))
+		}
+		sections[i] = section{title: fmt.Sprintf("%d. %s", i+1, title), body: strings.Join(body, "\n")}
+	}
+	return sections
+}
+
+func main() {
+	p := tea.NewProgram(newModel(document()), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestJumpToSection(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(document()), teatest.WithInitialTermSize(80, 12))
+
+	waitForString(t, tm, "> 1. NAME")
+
+	// Select EXIT STATUS and jump to it.
+	tm.Type("jjjjjjjj")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "  │ 9. EXIT STATUS")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.viewport.YOffset != fm.headings[8] {
+		t.Errorf("expected heading at the top, at line %d, got offset %d", fm.headings[8], fm.viewport.YOffset)
+	}
+}
+
+func TestJumpToLastSection(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(document()), teatest.WithInitialTermSize(80, 12))
+
+	waitForString(t, tm, "> 1. NAME")
+
+	// The table of contents is longer than the sidebar, so it scrolls to
+	// keep the last entry in view.
+	for range 25 {
+		tm.Type("j")
+	}
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForStrings(t, tm, "> 20. SEE ALSO", "100%")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.offset != 9 {
+		t.Errorf("expected table of contents to scroll to entry 10, got %d", fm.offset+1)
+	}
+	// The last section is too short to be scrolled to the top, but its
+	// heading is still shown.
+	if !strings.Contains(fm.viewport.View(), "20. SEE ALSO") {
+		t.Errorf("expected heading to be visible:\n%s", fm.viewport.View())
+	}
+	if fm.viewport.YOffset >= fm.headings[19] {
+		t.Errorf("expected viewport to stop short of the heading")
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	waitForStrings(t, tm, s)
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
+
+func TestEnterWithoutHeadings(t *testing.T) {
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+
+	// Before the first size, the document hasn't been rendered.
+	var m tea.Model = newModel(document())
+	m.Update(enter)
+
+	// A page with no sections has no headings to jump to.
+	m = newModel(nil)
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 80, Height: 12})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(enter)
+	if got := m.(model).cursor; got != 0 {
+		t.Errorf("expected cursor to stay at 0, got %d", got)
+	}
+	m.View()
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// frame is how long each position of the marquee is shown for.
+	frame = 150 * time.Millisecond
+	// pauseFrames is how many frames the marquee rests at the start of each
+	// loop, so the beginning of the text can be read.
+	pauseFrames = 10
+	// gap separates the end of the text from its start as it loops round.
+	gap = "   •   "
+)
+
+var (
+	titleStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("205"))
+	paneStyle  = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color("240"))
+)
+
+type tickMsg struct{}
+
+type model struct {
+	text string
+	// width is the width of the pane, inside its border.
+	width int
+	// offset is the position in the looped text at which the visible window
+	// starts.
+	offset int
+	// pause is the number of frames left to rest for before scrolling.
+	pause int
+	// scrolling is true while a tick is pending, to avoid starting a
+	// second tick loop on a resize.
+	scrolling bool
+}
+
+func newModel(text string) model {
+	return model{text: text, pause: pauseFrames}
+}
+
+// fits reports whether the text can be shown in full without scrolling.
+func (m model) fits() bool {
+	return len([]rune(m.text)) <= m.width
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func tick() tea.Cmd {
+	return tea.Tick(frame, func(time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case tea.WindowSizeMsg:
+		m.width = max(0, msg.Width-paneStyle.GetHorizontalFrameSize())
+		if !m.fits() && !m.scrolling {
+			m.scrolling = true
+			return m, tick()
+		}
+	case tickMsg:
+		if m.fits() {
+			// The pane has grown to fit the text, so stop scrolling and
+			// show it from the start.
+			m.scrolling = false
+			m.offset, m.pause = 0, pauseFrames
+			return m, nil
+		}
+		if m.pause > 0 {
+			m.pause--
+			return m, tick()
+		}
+		m.offset = (m.offset + 1) % len([]rune(m.text+gap))
+		if m.offset == 0 {
+			m.pause = pauseFrames
+		}
+		return m, tick()
+	}
+	return m, nil
+}
+
+// window returns width runes of the text looped round with the gap,
+// starting at offset.
+func window(text string, offset, width int) string {
+	loop := []rune(text + gap)
+	visible := make([]rune, width)
+	for i := range visible {
+		visible[i] = loop[(offset+i)%len(loop)]
+	}
+	return string(visible)
+}
+
+func (m model) View() string {
+	if m.width == 0 {
+		return ""
+	}
+	line := m.text
+	if !m.fits() {
+		line = window(m.text, m.offset, m.width)
+	}
+	return titleStyle.Render("♪ Now playing") + "\n" + paneStyle.Width(m.width).Render(line) + "\n"
+}
+
+func main() {
+	m := newModel("Godspeed You! Black Emperor — Storm (from Lift Your Skinny Fists Like Antennas to Heaven)")
+	p := tea.NewProgram(m)
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
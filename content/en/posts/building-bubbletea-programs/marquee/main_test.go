@@ -0,0 +1,82 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+const song = "Godspeed You! Black Emperor — Storm"
+
+func TestScroll(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(song), teatest.WithInitialTermSize(22, 10))
+
+	// The pane is 20 wide inside its border.
+	waitForStrings(t, tm, "│"+window(song, 0, 20)+"│")
+	waitForStrings(t, tm, "│"+window(song, 1, 20)+"│")
+	waitForStrings(t, tm, "│"+window(song, 2, 20)+"│")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestExactFit(t *testing.T) {
+	m := newModel(song)
+	mm, cmd := m.Update(tea.WindowSizeMsg{Width: len([]rune(song)) + 2, Height: 10})
+	if cmd != nil {
+		t.Error("expected no tick when the text fits exactly")
+	}
+	if view := mm.View(); !strings.Contains(view, "│"+song+"│") {
+		t.Errorf("expected the text in full:\n%s", view)
+	}
+}
+
+func TestResize(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(song), teatest.WithInitialTermSize(80, 10))
+
+	waitForStrings(t, tm, song)
+
+	// Too narrow: the text starts scrolling.
+	tm.Send(tea.WindowSizeMsg{Width: 22, Height: 10})
+
+	waitForStrings(t, tm, "│"+window(song, 1, 20)+"│")
+
+	// Wide enough again: the text is shown in full and stops scrolling.
+	tm.Send(tea.WindowSizeMsg{Width: 80, Height: 10})
+
+	waitForStrings(t, tm, song)
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestWindowLoops(t *testing.T) {
+	got := window("abc", 2, 6)
+	if want := "c   • "; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
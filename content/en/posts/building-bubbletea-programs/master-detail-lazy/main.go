@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	listStyle   = lipgloss.NewStyle().
+			Width(16).
+			Border(lipgloss.NormalBorder(), false, true, false, false)
+	detailStyle = lipgloss.NewStyle().Padding(0, 2)
+)
+
+type detailMsg struct {
+	name   string
+	detail string
+}
+
+type model struct {
+	items   []string
+	cursor  int
+	fetch   func(name string) string
+	cache   map[string]string
+	spinner spinner.Model
+}
+
+func newModel(items []string, fetch func(name string) string) model {
+	return model{
+		items:   items,
+		fetch:   fetch,
+		cache:   make(map[string]string),
+		spinner: spinner.New(),
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, m.load())
+}
+
+// load returns a command to fetch the selected item's detail, unless it's
+// already cached.
+func (m model) load() tea.Cmd {
+	name := m.items[m.cursor]
+	if _, ok := m.cache[name]; ok {
+		return nil
+	}
+	fetch := m.fetch
+	return func() tea.Msg {
+		return detailMsg{name: name, detail: fetch(name)}
+	}
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+				return m, m.load()
+			}
+		case "down", "j":
+			if m.cursor < len(m.items)-1 {
+				m.cursor++
+				return m, m.load()
+			}
+		}
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+	case detailMsg:
+		if msg.name != m.items[m.cursor] {
+			// The user has moved on since this was requested.
+			return m, nil
+		}
+		m.cache[msg.name] = msg.detail
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	var list strings.Builder
+	for i, item := range m.items {
+		if i == m.cursor {
+			list.WriteString(cursorStyle.Render("> " + item))
+		} else {
+			list.WriteString("  " + item)
+		}
+		list.WriteString("\n")
+	}
+
+	name := m.items[m.cursor]
+	detail, ok := m.cache[name]
+	if !ok {
+		detail = m.spinner.View() + " loading " + name + "..."
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		listStyle.Render(list.String()),
+		detailStyle.Render(detail),
+	) + "\n\nj/k: select • q: quit\n"
+}
+
+// fetch simulates fetching an item's detail from a slow API.
+func fetch(name string) string {
+	time.Sleep(time.Duration(300+rand.Intn(1200)) * time.Millisecond)
+	return fmt.Sprintf("%s\n\nowner:   team-%d\nupdated: %s", name, rand.Intn(10), time.Now().Format(time.TimeOnly))
+}
+
+func main() {
+	m := newModel([]string{"api", "billing", "frontend", "search", "worker"}, fetch)
+	p := tea.NewProgram(m, tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
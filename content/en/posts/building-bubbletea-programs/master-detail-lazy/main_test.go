@@ -0,0 +1,112 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+// fakeAPI returns details only when told to, and counts fetches.
+type fakeAPI struct {
+	release map[string]chan string
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func newFakeAPI(names ...string) *fakeAPI {
+	api := &fakeAPI{release: make(map[string]chan string), calls: make(map[string]int)}
+	for _, name := range names {
+		api.release[name] = make(chan string)
+	}
+	return api
+}
+
+func (a *fakeAPI) fetch(name string) string {
+	a.mu.Lock()
+	a.calls[name]++
+	a.mu.Unlock()
+	return <-a.release[name]
+}
+
+func (a *fakeAPI) count(name string) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.calls[name]
+}
+
+func TestDetail(t *testing.T) {
+	api := newFakeAPI("alpha", "bravo")
+	tm := teatest.NewTestModel(t, newModel([]string{"alpha", "bravo"}, api.fetch), teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "loading alpha...")
+
+	api.release["alpha"] <- "alpha is the first"
+
+	waitForString(t, tm, "alpha is the first")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+
+	waitForString(t, tm, "loading bravo...")
+
+	api.release["bravo"] <- "bravo is the second"
+
+	waitForString(t, tm, "bravo is the second")
+
+	// Reselecting alpha renders the cached detail without fetching it
+	// again.
+	tm.Send(tea.KeyMsg{Type: tea.KeyUp})
+
+	waitForString(t, tm, "alpha is the first")
+
+	tm.Type("q")
+	tm.WaitFinished(t)
+
+	if n := api.count("alpha"); n != 1 {
+		t.Errorf("expected alpha to be fetched once, got %d", n)
+	}
+}
+
+func TestStaleDetail(t *testing.T) {
+	api := newFakeAPI("alpha", "bravo", "charlie")
+	tm := teatest.NewTestModel(t, newModel([]string{"alpha", "bravo", "charlie"}, api.fetch), teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "loading alpha...")
+
+	// Move past bravo before either alpha or bravo has loaded.
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+
+	waitForString(t, tm, "loading charlie...")
+
+	api.release["alpha"] <- "alpha is the first"
+	api.release["bravo"] <- "bravo is the second"
+	api.release["charlie"] <- "charlie is the third"
+
+	waitForString(t, tm, "charlie is the third")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	for _, name := range []string{"alpha", "bravo"} {
+		if _, ok := fm.cache[name]; ok {
+			t.Errorf("expected stale detail for %s to be discarded", name)
+		}
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
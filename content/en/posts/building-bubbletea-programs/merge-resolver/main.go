@@ -0,0 +1,267 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	startMarker = "<<<<<<<"
+	sepMarker   = "======="
+	endMarker   = ">>>>>>>"
+)
+
+var (
+	oursStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+	theirsStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("12"))
+	errorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	currentStyle = lipgloss.NewStyle().Border(lipgloss.NormalBorder(), false, false, false, true).BorderForeground(lipgloss.Color("205")).PaddingLeft(1)
+	otherStyle   = lipgloss.NewStyle().PaddingLeft(2)
+	paneStyle    = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+)
+
+type resolution int
+
+const (
+	unresolved resolution = iota
+	takeOurs
+	takeTheirs
+	keepBoth
+)
+
+func (r resolution) String() string {
+	return [...]string{"unresolved", "ours", "theirs", "both"}[r]
+}
+
+// segment is either plain text, or a conflict hunk.
+type segment struct {
+	lines []string
+
+	conflict   bool
+	ours       []string
+	theirs     []string
+	resolution resolution
+	// err is set if the hunk's markers are malformed, in which case it
+	// cannot be resolved and is left as-is.
+	err error
+}
+
+// resolved returns the lines the segment contributes to the output.
+func (s segment) resolved() []string {
+	if !s.conflict || s.err != nil {
+		return s.lines
+	}
+	switch s.resolution {
+	case takeOurs:
+		return s.ours
+	case takeTheirs:
+		return s.theirs
+	case keepBoth:
+		return append(append([]string{}, s.ours...), s.theirs...)
+	}
+	return s.lines
+}
+
+// parse splits a buffer into plain text segments and conflict hunks.
+func parse(buf string) []segment {
+	var (
+		segments []segment
+		plain    []string
+		hunk     *segment
+		// inTheirs is true once the separator of the current hunk is seen.
+		inTheirs bool
+		// depth counts nested start markers within the current hunk.
+		depth int
+	)
+	lines := strings.Split(buf, "\n")
+	for i, line := range lines {
+		if hunk == nil {
+			if strings.HasPrefix(line, startMarker) {
+				if len(plain) > 0 {
+					segments = append(segments, segment{lines: plain})
+					plain = nil
+				}
+				hunk = &segment{conflict: true, lines: []string{line}}
+				inTheirs = false
+				depth = 0
+				continue
+			}
+			plain = append(plain, line)
+			continue
+		}
+
+		hunk.lines = append(hunk.lines, line)
+		switch {
+		case strings.HasPrefix(line, startMarker):
+			depth++
+			if hunk.err == nil {
+				hunk.err = fmt.Errorf("nested conflict marker on line %d", i+1)
+			}
+		case strings.HasPrefix(line, sepMarker) && depth == 0:
+			if inTheirs && hunk.err == nil {
+				hunk.err = fmt.Errorf("duplicate separator on line %d", i+1)
+			}
+			inTheirs = true
+		case strings.HasPrefix(line, endMarker):
+			if depth > 0 {
+				depth--
+				continue
+			}
+			if !inTheirs && hunk.err == nil {
+				hunk.err = fmt.Errorf("missing separator before line %d", i+1)
+			}
+			segments = append(segments, *hunk)
+			hunk = nil
+		case inTheirs:
+			hunk.theirs = append(hunk.theirs, line)
+		default:
+			hunk.ours = append(hunk.ours, line)
+		}
+	}
+	if hunk != nil {
+		hunk.err = errors.New("conflict is not terminated")
+		segments = append(segments, *hunk)
+	}
+	if len(plain) > 0 {
+		segments = append(segments, segment{lines: plain})
+	}
+	return segments
+}
+
+type model struct {
+	segments []segment
+	// conflicts holds the indices of the conflict segments.
+	conflicts []int
+	current   int
+}
+
+func newModel(buf string) model {
+	m := model{segments: parse(buf)}
+	for i, s := range m.segments {
+		if s.conflict {
+			m.conflicts = append(m.conflicts, i)
+		}
+	}
+	return m
+}
+
+// output returns the buffer with resolved conflicts replaced.
+func (m model) output() string {
+	var lines []string
+	for _, s := range m.segments {
+		lines = append(lines, s.resolved()...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "n", "down":
+			m.current = min(m.current+1, max(0, len(m.conflicts)-1))
+		case "p", "up":
+			m.current = max(m.current-1, 0)
+		case "o":
+			m.resolve(takeOurs)
+		case "t":
+			m.resolve(takeTheirs)
+		case "b":
+			m.resolve(keepBoth)
+		case "u":
+			m.resolve(unresolved)
+		}
+	}
+	return m, nil
+}
+
+func (m *model) resolve(r resolution) {
+	if len(m.conflicts) == 0 {
+		return
+	}
+	s := &m.segments[m.conflicts[m.current]]
+	if s.err != nil {
+		return
+	}
+	s.resolution = r
+}
+
+func (m model) View() string {
+	var hunks []string
+	for i, idx := range m.conflicts {
+		s := m.segments[idx]
+		header := fmt.Sprintf("conflict %d of %d: %s", i+1, len(m.conflicts), s.resolution)
+		var body string
+		if s.err != nil {
+			header = fmt.Sprintf("conflict %d of %d: ", i+1, len(m.conflicts)) + errorStyle.Render("error: "+s.err.Error())
+			body = strings.Join(s.lines, "\n")
+		} else {
+			body = oursStyle.Render(strings.Join(s.ours, "\n")) + "\n" + sepMarker + "\n" + theirsStyle.Render(strings.Join(s.theirs, "\n"))
+		}
+		style := otherStyle
+		if i == m.current {
+			style = currentStyle
+		}
+		hunks = append(hunks, style.Render(header+"\n"+body))
+	}
+	if len(hunks) == 0 {
+		hunks = []string{"no conflicts"}
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.JoinHorizontal(lipgloss.Top,
+			paneStyle.Render(strings.Join(hunks, "\n\n")),
+			paneStyle.Render("output\n\n"+m.output()),
+		),
+		"n/p: next/prev • o: ours • t: theirs • b: both • u: undo • q: quit",
+	)
+}
+
+const example = `package main
+
+import "fmt"
+
+func main() {
+<<<<<<< HEAD
+	fmt.Println("hello")
+=======
+	fmt.Println("hello, world")
+>>>>>>> feature
+	greet("bubbletea")
+}
+
+func greet(name string) {
+<<<<<<< HEAD
+	fmt.Printf("hi %s\n", name)
+=======
+	fmt.Printf("hello %s\n", name)
+>>>>>>> feature
+}`
+
+func main() {
+	buf := example
+	if len(os.Args) > 1 {
+		b, err := os.ReadFile(os.Args[1])
+		if err != nil {
+			fmt.Println("could not read file:", err)
+			os.Exit(1)
+		}
+		buf = string(b)
+	}
+	p := tea.NewProgram(newModel(buf))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+const conflicted = `a
+<<<<<<< HEAD
+ours
+=======
+theirs
+>>>>>>> branch
+b`
+
+func TestResolve(t *testing.T) {
+	m := newModel(conflicted)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "conflict 1 of 1: unresolved")
+
+	tm.Type("t")
+
+	// The drawn output pane holds the resolved buffer, without markers. The
+	// hunk pane still separates ours from theirs, so a single separator is
+	// expected.
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			s := string(b)
+			return strings.Contains(s, "conflict 1 of 1: theirs") &&
+				strings.Contains(s, "│ a      │") &&
+				strings.Contains(s, "│ theirs │") &&
+				strings.Contains(s, "│ b      │") &&
+				!strings.Contains(s, startMarker) &&
+				!strings.Contains(s, endMarker) &&
+				strings.Count(s, sepMarker) == 1
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got, want := fm.output(), "a\ntheirs\nb"; got != want {
+		t.Errorf("expected output %q, got %q", want, got)
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		buf  string
+		want string
+	}{
+		{
+			name: "nested",
+			buf:  "<<<<<<< HEAD\nx\n<<<<<<< HEAD\ny\n=======\nz\n>>>>>>> b\n=======\nw\n>>>>>>> b",
+			want: "nested conflict marker on line 3",
+		},
+		{
+			name: "missing separator",
+			buf:  "<<<<<<< HEAD\nx\n>>>>>>> b",
+			want: "missing separator before line 3",
+		},
+		{
+			name: "unterminated",
+			buf:  "<<<<<<< HEAD\nx\n=======\ny",
+			want: "conflict is not terminated",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newModel(tt.buf)
+			if len(m.conflicts) != 1 {
+				t.Fatalf("expected 1 conflict, got %d", len(m.conflicts))
+			}
+			s := m.segments[m.conflicts[0]]
+			if s.err == nil || s.err.Error() != tt.want {
+				t.Errorf("expected error %q, got %v", tt.want, s.err)
+			}
+			// A malformed hunk can't be resolved, so it's left untouched.
+			m.resolve(takeOurs)
+			if m.output() != tt.buf {
+				t.Errorf("expected malformed hunk to be left as-is, got %q", m.output())
+			}
+		})
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
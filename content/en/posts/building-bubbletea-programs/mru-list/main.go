@@ -0,0 +1,201 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// capacity is the number of recently used items to remember.
+const capacity = 5
+
+var (
+	headingStyle = lipgloss.NewStyle().Bold(true)
+	cursorStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	errorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+)
+
+type savedMsg struct{ err error }
+
+// use moves the item to the front of the recently used list, dropping the
+// least recently used item if that takes it over the capacity. It reports
+// whether the list changed: using the most recent item again does nothing.
+func use(recent []string, item string, capacity int) ([]string, bool) {
+	if len(recent) > 0 && recent[0] == item {
+		return recent, false
+	}
+	updated := make([]string, 0, capacity)
+	updated = append(updated, item)
+	for _, r := range recent {
+		if r != item {
+			updated = append(updated, r)
+		}
+	}
+	if len(updated) > capacity {
+		updated = updated[:capacity]
+	}
+	return updated, true
+}
+
+// loadRecent reads the list saved by a previous run, one item per line. A
+// missing file is an empty list.
+func loadRecent(path string) ([]string, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	// Split on newlines only: an item may contain spaces.
+	var recent []string
+	for _, line := range strings.Split(string(b), "\n") {
+		if line != "" {
+			recent = append(recent, line)
+		}
+	}
+	return recent, nil
+}
+
+// saver writes the list to the file. Each change is saved by its own
+// command, and commands run concurrently, so writes are made one at a time,
+// and one older than the last written is skipped rather than allowed to
+// overwrite it.
+type saver struct {
+	mu      sync.Mutex
+	path    string
+	written int
+}
+
+func (s *saver) write(seq int, content string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if seq <= s.written {
+		return nil
+	}
+	if err := os.WriteFile(s.path, []byte(content), 0o644); err != nil {
+		return err
+	}
+	s.written = seq
+	return nil
+}
+
+func (m *model) save() tea.Cmd {
+	m.seq++
+	seq, saver := m.seq, m.saver
+	content := strings.Join(m.recent, "\n") + "\n"
+	return func() tea.Msg {
+		return savedMsg{err: saver.write(seq, content)}
+	}
+}
+
+type model struct {
+	path  string
+	saver *saver
+	// seq numbers each save, so that the saver can tell which is the latest.
+	seq      int
+	capacity int
+	items    []string
+	recent   []string
+	// cursor moves through the recent items followed by all the items.
+	cursor int
+	status string
+	err    error
+}
+
+func newModel(path string, capacity int, items, recent []string) model {
+	if len(recent) > capacity {
+		recent = recent[:capacity]
+	}
+	return model{path: path, saver: &saver{path: path}, capacity: capacity, items: items, recent: recent}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) rows() []string {
+	return slices.Concat(m.recent, m.items)
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = max(0, min(len(m.rows())-1, m.cursor+1))
+		case "enter":
+			if len(m.rows()) == 0 {
+				return m, nil
+			}
+			item := m.rows()[m.cursor]
+			recent, changed := use(m.recent, item, m.capacity)
+			m.cursor = 0
+			if !changed {
+				m.status = item + " is already the most recent"
+				return m, nil
+			}
+			m.recent = recent
+			m.status = "opened " + item
+			return m, m.save()
+		}
+	case savedMsg:
+		m.err = msg.err
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	row := 0
+	section := func(heading string, items []string) {
+		b.WriteString(headingStyle.Render(heading) + "\n")
+		if len(items) == 0 {
+			b.WriteString("  (none yet)\n")
+		}
+		for _, item := range items {
+			if row == m.cursor {
+				b.WriteString(cursorStyle.Render("> "+item) + "\n")
+			} else {
+				b.WriteString("  " + item + "\n")
+			}
+			row++
+		}
+		b.WriteString("\n")
+	}
+	section(fmt.Sprintf("Recent (%d/%d)", len(m.recent), m.capacity), m.recent)
+	section("All", m.items)
+	if m.err != nil {
+		b.WriteString(errorStyle.Render("could not save recent items: "+m.err.Error()) + "\n")
+	} else if m.status != "" {
+		b.WriteString(m.status + "\n")
+	}
+	b.WriteString("j/k: move • enter: open • q: quit\n")
+	return b.String()
+}
+
+func main() {
+	path := filepath.Join(os.TempDir(), "mru-list.txt")
+	recent, err := loadRecent(path)
+	if err != nil {
+		fmt.Println("could not load recent items:", err)
+		os.Exit(1)
+	}
+	items := []string{"api-gateway", "billing", "dashboard", "docs", "infra", "mobile", "search", "website"}
+	p := tea.NewProgram(newModel(path, capacity, items, recent))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+var items = []string{"alpha", "bravo", "charlie", "delta", "echo"}
+
+func TestMRU(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recent.txt")
+	if err := os.WriteFile(path, []byte("charlie\nbravo\nalpha\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	recent, err := loadRecent(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := newModel(path, 3, items, recent)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "Recent (3/3)")
+
+	// Select the middle recent item.
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "opened bravo")
+
+	// Select delta from the full list: the list is full, so alpha, the
+	// least recently used, is dropped.
+	for range 3 + 3 {
+		tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "opened delta")
+
+	// Selecting the top item again changes nothing.
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "delta is already the most recent")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	want := []string{"delta", "bravo", "charlie"}
+	if !slices.Equal(fm.recent, want) {
+		t.Errorf("want recent %v, got %v", want, fm.recent)
+	}
+	// The next run starts from where this one left off. The save runs in a
+	// command, so it may finish after the program does.
+	var saved []string
+	for start := time.Now(); time.Since(start) < time.Second; time.Sleep(10 * time.Millisecond) {
+		if saved, err = loadRecent(path); err == nil && slices.Equal(saved, want) {
+			return
+		}
+	}
+	t.Errorf("want saved %v, got %v (%v)", want, saved, err)
+}
+
+func TestUse(t *testing.T) {
+	tests := []struct {
+		name    string
+		recent  []string
+		item    string
+		want    []string
+		changed bool
+	}{
+		{"empty", nil, "a", []string{"a"}, true},
+		{"already at top", []string{"a", "b"}, "a", []string{"a", "b"}, false},
+		{"moves to top", []string{"a", "b", "c"}, "c", []string{"c", "a", "b"}, true},
+		{"full drops oldest", []string{"a", "b", "c"}, "d", []string{"d", "a", "b"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := use(tt.recent, tt.item, 3)
+			if !slices.Equal(got, tt.want) || changed != tt.changed {
+				t.Errorf("want %v (%t), got %v (%t)", tt.want, tt.changed, got, changed)
+			}
+		})
+	}
+}
+
+func TestLoadMissing(t *testing.T) {
+	recent, err := loadRecent(filepath.Join(t.TempDir(), "missing.txt"))
+	if err != nil || recent != nil {
+		t.Errorf("expected no items and no error, got %v, %v", recent, err)
+	}
+}
+
+func TestItemsWithSpaces(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recent.txt")
+	if err := os.WriteFile(path, []byte("my notes.txt\nREADME.md\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	recent, err := loadRecent(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"my notes.txt", "README.md"}; !slices.Equal(recent, want) {
+		t.Errorf("want %q, got %q", want, recent)
+	}
+}
+
+func TestSavesOutOfOrder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recent.txt")
+	m := newModel(path, 3, []string{"a.go", "b.go"}, nil)
+
+	m.recent = []string{"a.go"}
+	first := m.save()
+	m.recent = []string{"b.go", "a.go"}
+	second := m.save()
+
+	// The second save finishes before the first, which mustn't overwrite
+	// it.
+	second()
+	first()
+
+	recent, err := loadRecent(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"b.go", "a.go"}; !slices.Equal(recent, want) {
+		t.Errorf("expected the latest list to be saved, want %q, got %q", want, recent)
+	}
+}
+
+func TestNoItems(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recent.txt")
+	tm := teatest.NewTestModel(t, newModel(path, 3, nil, nil), teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "(none yet)")
+
+	tm.Type("j")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.cursor != 0 || fm.recent != nil {
+		t.Errorf("expected nothing to happen, got cursor %d and recent %v", fm.cursor, fm.recent)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// concurrency is the maximum number of tasks to run at once.
+const concurrency = 2
+
+var (
+	doneStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+	failedStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	pendingStyle = lipgloss.NewStyle().Faint(true)
+)
+
+type state int
+
+const (
+	pending state = iota
+	running
+	done
+	failed
+)
+
+type task struct {
+	name    string
+	state   state
+	err     error
+	spinner spinner.Model
+}
+
+type resultMsg struct {
+	idx int
+	err error
+}
+
+type model struct {
+	tasks []task
+	run   func(name string) error
+	// ticker drives the animation of every running task's spinner, so only
+	// one tick loop is needed however many tasks there are.
+	ticker spinner.Model
+}
+
+func newModel(names []string, run func(name string) error) model {
+	styles := []spinner.Spinner{spinner.Dot, spinner.Line, spinner.MiniDot, spinner.Jump}
+	tasks := make([]task, len(names))
+	for i, name := range names {
+		tasks[i] = task{name: name, spinner: spinner.New(spinner.WithSpinner(styles[i%len(styles)]))}
+	}
+	return model{tasks: tasks, run: run, ticker: spinner.New()}
+}
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.ticker.Tick, m.startPending())
+}
+
+// startPending starts pending tasks until the concurrency limit is reached.
+func (m *model) startPending() tea.Cmd {
+	var active int
+	for _, t := range m.tasks {
+		if t.state == running {
+			active++
+		}
+	}
+	var cmds []tea.Cmd
+	for i := range m.tasks {
+		if active == concurrency {
+			break
+		}
+		if m.tasks[i].state != pending {
+			continue
+		}
+		m.tasks[i].state = running
+		active++
+		idx, name, run := i, m.tasks[i].name, m.run
+		cmds = append(cmds, func() tea.Msg {
+			return resultMsg{idx: idx, err: run(name)}
+		})
+	}
+	return tea.Batch(cmds...)
+}
+
+func (m model) finished() bool {
+	for _, t := range m.tasks {
+		if t.state == pending || t.state == running {
+			return false
+		}
+	}
+	return true
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.ticker, cmd = m.ticker.Update(msg)
+		for i := range m.tasks {
+			if m.tasks[i].state != running {
+				continue
+			}
+			// Re-address the shared tick to each task's spinner, ignoring
+			// the command each returns to schedule its own next tick.
+			tick := spinner.TickMsg{ID: m.tasks[i].spinner.ID(), Time: msg.Time}
+			m.tasks[i].spinner, _ = m.tasks[i].spinner.Update(tick)
+		}
+		return m, cmd
+	case resultMsg:
+		t := &m.tasks[msg.idx]
+		t.err = msg.err
+		if msg.err != nil {
+			t.state = failed
+		} else {
+			t.state = done
+		}
+		if m.finished() {
+			return m, tea.Quit
+		}
+		return m, m.startPending()
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	for _, t := range m.tasks {
+		switch t.state {
+		case pending:
+			b.WriteString(pendingStyle.Render("· " + t.name))
+		case running:
+			b.WriteString(t.spinner.View() + " " + t.name)
+		case done:
+			b.WriteString(doneStyle.Render("✓") + " " + t.name)
+		case failed:
+			b.WriteString(failedStyle.Render("✗") + " " + t.name + ": " + failedStyle.Render(t.err.Error()))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func run(name string) error {
+	time.Sleep(time.Duration(500+rand.Intn(2000)) * time.Millisecond)
+	if name == "publish" {
+		return errors.New("permission denied")
+	}
+	return nil
+}
+
+func main() {
+	m := newModel([]string{"fetch", "compile", "test", "package", "publish"}, run)
+	p := tea.NewProgram(m)
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
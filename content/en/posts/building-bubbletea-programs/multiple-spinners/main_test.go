@@ -0,0 +1,57 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestTasks(t *testing.T) {
+	// release controls when each task finishes.
+	release := map[string]chan error{
+		"build": make(chan error),
+		"test":  make(chan error),
+		"ship":  make(chan error),
+	}
+	m := newModel([]string{"build", "test", "ship"}, func(name string) error {
+		return <-release[name]
+	})
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	// Only two tasks run at once, so the third is pending.
+	waitForString(t, tm, "· ship")
+
+	release["build"] <- nil
+
+	waitForString(t, tm, "✓ build")
+
+	release["ship"] <- errors.New("upload failed")
+
+	waitForString(t, tm, "✗ ship: upload failed")
+
+	release["test"] <- nil
+
+	// The program quits by itself once every task has completed.
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	view := fm.View()
+	for _, want := range []string{"✓ build", "✓ test", "✗ ship: upload failed"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected view to contain %q, got:\n%s", want, view)
+		}
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
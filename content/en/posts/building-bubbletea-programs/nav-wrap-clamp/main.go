@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	modeStyle   = lipgloss.NewStyle().Bold(true)
+)
+
+type model struct {
+	items  []string
+	cursor int
+	// wrap moves the cursor from one end of the list to the other, instead
+	// of stopping at the end.
+	wrap bool
+}
+
+func newModel(items []string) model {
+	return model{items: items}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.move(-1)
+		case "down", "j":
+			m.move(1)
+		case "w":
+			m.wrap = !m.wrap
+		}
+	}
+	return m, nil
+}
+
+func (m *model) move(delta int) {
+	n := len(m.items)
+	if n == 0 {
+		// Nothing to move to; with wrapping, the modulo below would divide
+		// by zero.
+		return
+	}
+	if m.wrap {
+		m.cursor = ((m.cursor+delta)%n + n) % n
+	} else {
+		m.cursor = max(0, min(n-1, m.cursor+delta))
+	}
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	mode := "clamp"
+	if m.wrap {
+		mode = "wrap"
+	}
+	b.WriteString("mode: " + modeStyle.Render(mode) + "\n\n")
+	if len(m.items) == 0 {
+		b.WriteString("  no items\n")
+	}
+	for i, item := range m.items {
+		if i == m.cursor {
+			b.WriteString(cursorStyle.Render("> "+item) + "\n")
+		} else {
+			b.WriteString("  " + item + "\n")
+		}
+	}
+	b.WriteString("\nj/k: move • w: toggle wrap • q: quit\n")
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel([]string{"Mercury", "Venus", "Earth", "Mars", "Jupiter", "Saturn", "Uranus", "Neptune"}))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
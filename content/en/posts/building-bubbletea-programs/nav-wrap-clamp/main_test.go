@@ -0,0 +1,82 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestWrapAndClamp(t *testing.T) {
+	m := newModel([]string{"one", "two", "three"})
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	tm.Type("w")
+
+	waitForString(t, tm, "mode: wrap")
+
+	// Moving up from the top wraps round to the bottom.
+	tm.Send(tea.KeyMsg{Type: tea.KeyUp})
+
+	waitForString(t, tm, "> three")
+
+	// And back down to the top again.
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+
+	waitForString(t, tm, "> one")
+
+	tm.Type("w")
+
+	waitForString(t, tm, "mode: clamp")
+
+	// With clamping, the cursor stays at the top.
+	tm.Send(tea.KeyMsg{Type: tea.KeyUp})
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.cursor != 0 {
+		t.Errorf("expected cursor to stay at the top, got %d", fm.cursor)
+	}
+}
+
+func TestMove(t *testing.T) {
+	tests := []struct {
+		name   string
+		items  int
+		cursor int
+		wrap   bool
+		delta  int
+		want   int
+	}{
+		{"clamp at bottom", 3, 2, false, 1, 2},
+		{"wrap at bottom", 3, 2, true, 1, 0},
+		{"single item clamp", 1, 0, false, -1, 0},
+		{"single item wrap", 1, 0, true, -1, 0},
+		{"empty clamp", 0, 0, false, 1, 0},
+		{"empty wrap", 0, 0, true, -1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newModel(make([]string, tt.items))
+			m.cursor, m.wrap = tt.cursor, tt.wrap
+			m.move(tt.delta)
+			if m.cursor != tt.want {
+				t.Errorf("want cursor %d, got %d", tt.want, m.cursor)
+			}
+		})
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	outerStyle = lipgloss.NewStyle().
+			Border(lipgloss.DoubleBorder()).
+			Padding(0, 2)
+	innerStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			Padding(0, 1)
+)
+
+// minContentWidth is the narrowest content an inner box will wrap to.
+const minContentWidth = 10
+
+// minWidth is the narrowest terminal that can fit the outer box with two
+// inner boxes, each with at least minContentWidth columns of content.
+var minWidth = outerStyle.GetHorizontalFrameSize() + 2*(innerStyle.GetHorizontalFrameSize()+minContentWidth)
+
+type model struct {
+	width, height int
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case msg.String() == "ctrl+c":
+			return m, tea.Quit
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.width < minWidth {
+		return lipgloss.NewStyle().Width(m.width).Render("terminal too narrow")
+	}
+
+	// Space left inside the outer box's border and padding, split between
+	// the two inner boxes.
+	available := m.width - outerStyle.GetHorizontalFrameSize()
+	leftWidth := available / 2
+	rightWidth := available - leftWidth
+
+	left := innerStyle.
+		// Width includes padding but not borders.
+		Width(leftWidth - innerStyle.GetHorizontalBorderSize()).
+		Render("left: the quick brown fox jumps over the lazy dog")
+	right := innerStyle.
+		Width(rightWidth - innerStyle.GetHorizontalBorderSize()).
+		Render("right: pack my box with five dozen liquor jugs")
+
+	return outerStyle.
+		Width(m.width - outerStyle.GetHorizontalBorderSize()).
+		Render(lipgloss.JoinHorizontal(lipgloss.Top, left, right))
+}
+
+func main() {
+	p := tea.NewProgram(model{}, tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
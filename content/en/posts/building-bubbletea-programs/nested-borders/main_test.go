@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestWidth(t *testing.T) {
+	// minWidth is the edge case of a terminal exactly as wide as the
+	// outer box's frame plus the inner boxes' frames.
+	for _, width := range []int{minWidth, 40, 80, 121} {
+		t.Run(fmt.Sprint(width), func(t *testing.T) {
+			tm := teatest.NewTestModel(t, model{}, teatest.WithInitialTermSize(width, 24))
+
+			waitForString(t, tm, "╝")
+
+			tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+			view := tm.FinalModel(t).View()
+			for i, line := range strings.Split(view, "\n") {
+				if got := lipgloss.Width(line); got != width {
+					t.Errorf("line %d: expected width %d, got %d: %q", i, width, got, line)
+				}
+			}
+		})
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
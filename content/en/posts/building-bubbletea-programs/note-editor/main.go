@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var statusStyle = lipgloss.NewStyle().Faint(true)
+
+type savedMsg struct{ err error }
+
+type model struct {
+	path     string
+	textarea textarea.Model
+	status   string
+}
+
+func newModel(path string) model {
+	ta := textarea.New()
+	ta.Placeholder = "Write something..."
+	ta.ShowLineNumbers = false
+	ta.Focus()
+	return model{path: path, textarea: ta}
+}
+
+func (m model) Init() tea.Cmd {
+	return textarea.Blink
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "esc":
+			return m, tea.Quit
+		case "ctrl+s":
+			if strings.TrimSpace(m.textarea.Value()) == "" {
+				m.status = "note is empty, nothing saved"
+				return m, nil
+			}
+			return m, save(m.path, m.textarea.Value())
+		}
+		m.status = ""
+	case tea.WindowSizeMsg:
+		m.textarea.SetWidth(msg.Width)
+		m.textarea.SetHeight(msg.Height - 1)
+		// Setting the size doesn't scroll the textarea, so the cursor can
+		// end up out of view, e.g. when narrowing the width wraps lines
+		// above it. Updating the textarea scrolls it back into view, so
+		// fall through and pass the message on.
+	case savedMsg:
+		if msg.err != nil {
+			m.status = "error: " + msg.err.Error()
+		} else {
+			m.status = "saved to " + m.path
+		}
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.textarea, cmd = m.textarea.Update(msg)
+	return m, cmd
+}
+
+func save(path, content string) tea.Cmd {
+	return func() tea.Msg {
+		return savedMsg{err: os.WriteFile(path, []byte(content), 0o644)}
+	}
+}
+
+func (m model) View() string {
+	words := len(strings.Fields(m.textarea.Value()))
+	status := fmt.Sprintf("%d words • ctrl+s: save • esc: quit", words)
+	if m.status != "" {
+		status = m.status + " • " + status
+	}
+	return m.textarea.View() + "\n" + statusStyle.Render(status)
+}
+
+func main() {
+	p := tea.NewProgram(newModel("note.txt"), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestResize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "note.txt")
+	tm := teatest.NewTestModel(t, newModel(path), teatest.WithInitialTermSize(80, 24))
+
+	tm.Type("first line")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+	tm.Type("second line")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+	tm.Type("third")
+
+	waitForString(t, tm, "5 words")
+
+	// Shrink the terminal so only two lines of the note fit: the cursor,
+	// on the last line, should be scrolled into view.
+	tm.Send(tea.WindowSizeMsg{Width: 30, Height: 3})
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlS})
+
+	waitForString(t, tm, "saved to")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+
+	fm := tm.FinalModel(t).(model)
+	want := "first line\nsecond line\nthird"
+	if got := fm.textarea.Value(); got != want {
+		t.Errorf("expected content %q, got %q", want, got)
+	}
+	if row, col := fm.textarea.Line(), fm.textarea.LineInfo().CharOffset; row != 2 || col != 5 {
+		t.Errorf("expected cursor at 2:5, got %d:%d", row, col)
+	}
+	if view := fm.textarea.View(); !strings.Contains(view, "third") {
+		t.Errorf("expected cursor line to be in view, got:\n%s", view)
+	}
+	if b, err := os.ReadFile(path); err != nil || string(b) != want {
+		t.Errorf("expected saved file to contain %q, got %q (%v)", want, b, err)
+	}
+}
+
+func TestSaveEmpty(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "note.txt")
+	tm := teatest.NewTestModel(t, newModel(path), teatest.WithInitialTermSize(80, 24))
+
+	tm.Type("   ")
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlS})
+
+	waitForString(t, tm, "note is empty, nothing saved")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+	tm.WaitFinished(t)
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected no file to be written, got %v", err)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
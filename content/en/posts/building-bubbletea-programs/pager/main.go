@@ -0,0 +1,219 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	gutterStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	statusStyle = lipgloss.NewStyle().Reverse(true)
+)
+
+type model struct {
+	lines       []string
+	viewport    viewport.Model
+	ready       bool
+	lineNumbers bool
+
+	input     textinput.Model
+	searching bool
+	query     string
+	// count is the number typed before a command, e.g. the 50 in 50g.
+	count   string
+	message string
+}
+
+func newModel(content string) model {
+	input := textinput.New()
+	input.Prompt = "/"
+	return model{
+		lines: strings.Split(strings.TrimSuffix(content, "\n"), "\n"),
+		input: input,
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		if m.searching {
+			return m.updateSearch(msg)
+		}
+		m.message = ""
+		if len(msg.Runes) == 1 && msg.Runes[0] >= '0' && msg.Runes[0] <= '9' {
+			m.count += string(msg.Runes)
+			return m, nil
+		}
+		count := m.count
+		m.count = ""
+		switch msg.String() {
+		case "q":
+			return m, tea.Quit
+		case "g":
+			m.gotoLine(count, 1)
+			return m, nil
+		case "G":
+			m.gotoLine(count, len(m.lines))
+			return m, nil
+		case "l":
+			m.lineNumbers = !m.lineNumbers
+			m.render()
+			return m, nil
+		case "/":
+			m.searching = true
+			m.input.SetValue("")
+			return m, m.input.Focus()
+		case "n":
+			m.search(m.viewport.YOffset+1, 1)
+			return m, nil
+		case "N":
+			m.search(m.viewport.YOffset-1, -1)
+			return m, nil
+		}
+	case tea.WindowSizeMsg:
+		if !m.ready {
+			m.viewport = viewport.New(msg.Width, msg.Height-1)
+			m.ready = true
+		} else {
+			m.viewport.Width = msg.Width
+			m.viewport.Height = msg.Height - 1
+		}
+		m.render()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+func (m model) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		m.searching = false
+		m.input.Blur()
+		m.query = m.input.Value()
+		// Like less, search forwards from the line after the top of the
+		// screen.
+		m.search(m.viewport.YOffset+1, 1)
+		return m, nil
+	case "esc":
+		m.searching = false
+		m.input.Blur()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+// gotoLine scrolls so that the given 1-based line is at the top of the
+// screen, or def if no line is given. The viewport won't scroll past the
+// end, so the last lines are shown at the bottom instead.
+func (m *model) gotoLine(count string, def int) {
+	n := def
+	if count != "" {
+		var err error
+		// A count too large to parse is beyond the end of any file.
+		if n, err = strconv.Atoi(count); err != nil || n > len(m.lines) {
+			m.message = fmt.Sprintf("line %s is beyond the end (%d lines)", count, len(m.lines))
+			n = len(m.lines)
+		}
+	}
+	m.viewport.SetYOffset(max(1, n) - 1)
+}
+
+// search scrolls to the nearest line containing the query, starting at from
+// and moving in the given direction, wrapping around at either end.
+func (m *model) search(from, dir int) {
+	if m.query == "" {
+		return
+	}
+	for i := range len(m.lines) {
+		idx := ((from+i*dir)%len(m.lines) + len(m.lines)) % len(m.lines)
+		if strings.Contains(m.lines[idx], m.query) {
+			m.viewport.SetYOffset(idx)
+			return
+		}
+	}
+	m.message = "pattern not found: " + m.query
+}
+
+// render sets the viewport content, with or without line numbers.
+func (m *model) render() {
+	if !m.ready {
+		return
+	}
+	var b strings.Builder
+	width := len(strconv.Itoa(len(m.lines)))
+	for i, line := range m.lines {
+		if m.lineNumbers {
+			b.WriteString(gutterStyle.Render(fmt.Sprintf("%*d ", width, i+1)))
+		}
+		b.WriteString(line)
+		if i < len(m.lines)-1 {
+			b.WriteString("\n")
+		}
+	}
+	m.viewport.SetContent(b.String())
+}
+
+func (m model) View() string {
+	if !m.ready {
+		return "loading..."
+	}
+	return m.viewport.View() + "\n" + m.statusView()
+}
+
+func (m model) statusView() string {
+	if m.searching {
+		return m.input.View()
+	}
+	status := fmt.Sprintf("lines %d-%d/%d %3.f%%",
+		m.viewport.YOffset+1,
+		min(len(m.lines), m.viewport.YOffset+m.viewport.Height),
+		len(m.lines),
+		m.viewport.ScrollPercent()*100,
+	)
+	if m.message != "" {
+		status = m.message + " • " + status
+	}
+	return statusStyle.Render(status) + " /: search • g/G: top/bottom • l: line numbers • q: quit"
+}
+
+func main() {
+	var content string
+	if len(os.Args) > 1 {
+		b, err := os.ReadFile(os.Args[1])
+		if err != nil {
+			fmt.Println("could not read file:", err)
+			os.Exit(1)
+		}
+		content = string(b)
+	} else {
+		var b strings.Builder
+		for i := range 10000 {
+			fmt.Fprintf(&b, "log entry %d: everything is fine\n", i+1)
+		}
+		content = b.String()
+	}
+	p := tea.NewProgram(newModel(content), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
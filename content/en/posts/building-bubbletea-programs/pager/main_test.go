@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func buffer(n int) string {
+	var b strings.Builder
+	for i := range n {
+		fmt.Fprintf(&b, "this is line %d\n", i+1)
+	}
+	return b.String()
+}
+
+func TestBottom(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(buffer(5000)), teatest.WithInitialTermSize(80, 20))
+
+	waitForString(t, tm, "lines 1-19/5000")
+
+	tm.Type("G")
+
+	waitForStrings(t, tm, "this is line 5000", "lines 4982-5000/5000 100%")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestSearch(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(buffer(5000)), teatest.WithInitialTermSize(80, 20))
+
+	tm.Type("/line 4321")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "lines 4321-4339/5000")
+
+	tm.Type("/unicorn")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "pattern not found: unicorn")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	// A failed search doesn't move the viewport.
+	if fm.viewport.YOffset != 4320 {
+		t.Errorf("expected offset 4320, got %d", fm.viewport.YOffset)
+	}
+}
+
+func TestGotoLine(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(buffer(100)), teatest.WithInitialTermSize(80, 20))
+
+	tm.Type("l50g")
+
+	waitForString(t, tm, " 50 this is line 50")
+
+	tm.Type("999g")
+
+	waitForStrings(t, tm, "line 999 is beyond the end (100 lines)", "100 this is line 100")
+
+	tm.Type("g")
+
+	waitForString(t, tm, "  1 this is line 1")
+
+	// Too large to parse, but still beyond the end rather than the top.
+	tm.Type("99999999999999999999g")
+
+	waitForStrings(t, tm, "line 99999999999999999999 is beyond the end (100 lines)", "100 this is line 100")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	waitForStrings(t, tm, s)
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
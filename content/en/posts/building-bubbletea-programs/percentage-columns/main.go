@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// gutter is the number of cells between adjacent columns.
+	gutter = 2
+	// minWidth is the narrowest a column may be.
+	minWidth = 8
+	height   = 5
+)
+
+// percents are the shares of the width, less the gutters, given to each
+// column. They add up to 100.
+var percents = []int{25, 50, 25}
+
+var (
+	columnStyles = []lipgloss.Style{
+		lipgloss.NewStyle().Background(lipgloss.Color("24")),
+		lipgloss.NewStyle().Background(lipgloss.Color("54")),
+		lipgloss.NewStyle().Background(lipgloss.Color("22")),
+	}
+	warningStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+)
+
+// columns divides the width between columns according to their percents,
+// after setting aside the gutters between them. Cells left over from
+// rounding down go to the columns that lost the most to rounding, so the
+// columns and gutters always add up to the width exactly. It returns false
+// if the width is too narrow to give every column its minimum.
+func columns(width int, percents []int) ([]int, bool) {
+	available := width - gutter*(len(percents)-1)
+	if available < minWidth*len(percents) {
+		return nil, false
+	}
+	widths := make([]int, len(percents))
+	remainders := make([]int, len(percents))
+	used := 0
+	for i, p := range percents {
+		widths[i] = p * available / 100
+		remainders[i] = p * available % 100
+		used += widths[i]
+	}
+	order := make([]int, len(percents))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return remainders[b] - remainders[a]
+	})
+	for _, i := range order[:available-used] {
+		widths[i]++
+	}
+	// Bring any column below the minimum up to it, taking the cells from
+	// the widest column.
+	for i := range widths {
+		if deficit := minWidth - widths[i]; deficit > 0 {
+			widest := 0
+			for j := range widths {
+				if widths[j] > widths[widest] {
+					widest = j
+				}
+			}
+			widths[i] += deficit
+			widths[widest] -= deficit
+		}
+	}
+	return widths, true
+}
+
+type model struct {
+	width int
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.width == 0 {
+		return ""
+	}
+	widths, ok := columns(m.width, percents)
+	if !ok {
+		need := minWidth*len(percents) + gutter*(len(percents)-1)
+		return warningStyle.Render(fmt.Sprintf("too narrow: need %d columns", need)) + "\n"
+	}
+	space := lipgloss.NewStyle().Width(gutter).Height(height).Render("")
+	var cells []string
+	for i, w := range widths {
+		if i > 0 {
+			cells = append(cells, space)
+		}
+		label := fmt.Sprintf("%d%%\n%d wide", percents[i], w)
+		cells = append(cells, columnStyles[i].Width(w).Height(height).Render(label))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, cells...) + "\n" + strings.Repeat("─", m.width) + "\n"
+}
+
+func main() {
+	p := tea.NewProgram(model{}, tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
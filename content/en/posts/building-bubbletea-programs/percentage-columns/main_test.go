@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestWidths(t *testing.T) {
+	for _, width := range []int{80, 81, 82, 83, 101, 30} {
+		t.Run(fmt.Sprint(width), func(t *testing.T) {
+			tm := teatest.NewTestModel(t, model{}, teatest.WithInitialTermSize(width, 10))
+
+			waitForStrings(t, tm, "25%", "50%")
+
+			tm.Type("q")
+
+			fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second))
+			for i, line := range strings.Split(strings.TrimSuffix(fm.View(), "\n"), "\n") {
+				if got := lipgloss.Width(line); got != width {
+					t.Errorf("line %d: want width %d, got %d: %q", i, width, got, line)
+				}
+			}
+		})
+	}
+}
+
+func TestColumns(t *testing.T) {
+	tests := []struct {
+		width int
+		want  []int
+	}{
+		// 76 divides evenly.
+		{80, []int{19, 38, 19}},
+		// 77 doesn't: the middle column loses the most to rounding.
+		{81, []int{19, 39, 19}},
+		{83, []int{20, 39, 20}},
+		// 24 is exactly enough for the minimums, once the widest column has
+		// given up its cells to the others.
+		{28, []int{8, 8, 8}},
+		{40, []int{9, 18, 9}},
+		{34, []int{8, 14, 8}},
+	}
+	for _, tt := range tests {
+		got, ok := columns(tt.width, percents)
+		if !ok {
+			t.Errorf("%d: expected columns to fit", tt.width)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%d: want %v, got %v", tt.width, tt.want, got)
+		}
+	}
+}
+
+func TestTooNarrow(t *testing.T) {
+	if _, ok := columns(27, percents); ok {
+		t.Error("expected 27 to be too narrow")
+	}
+
+	var m tea.Model = model{}
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 20, Height: 10})
+	if view := m.View(); !strings.Contains(view, "too narrow: need 28 columns") {
+		t.Errorf("expected warning:\n%s", view)
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
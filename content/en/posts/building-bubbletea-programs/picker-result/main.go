@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"unordered/picker"
+)
+
+type model struct {
+	picker  picker.Model
+	picking bool
+	flavour string
+	status  string
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+	case picker.PickedMsg:
+		m.picking = false
+		m.flavour = msg.Value
+		m.status = ""
+		return m, nil
+	case picker.CancelledMsg:
+		m.picking = false
+		m.status = "cancelled"
+		return m, nil
+	}
+
+	if m.picking {
+		var cmd tea.Cmd
+		m.picker, cmd = m.picker.Update(msg)
+		return m, cmd
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q":
+			return m, tea.Quit
+		case "p":
+			m.picker = picker.New("Pick a flavour", "vanilla", "chocolate", "strawberry", "pistachio")
+			m.picking = true
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if m.picking {
+		return m.picker.View()
+	}
+	flavour := m.flavour
+	if flavour == "" {
+		flavour = "(none)"
+	}
+	s := "Flavour: " + flavour
+	if m.status != "" {
+		s += " (" + m.status + ")"
+	}
+	return s + "\n\np: pick • q: quit"
+}
+
+func main() {
+	p := tea.NewProgram(model{})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestPick(t *testing.T) {
+	tm := teatest.NewTestModel(t, model{}, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "Flavour: (none)")
+
+	tm.Type("p")
+
+	waitForString(t, tm, "Pick a flavour")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "Flavour: chocolate")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	fm := tm.FinalModel(t).(model)
+	if fm.picking {
+		t.Error("expected picker to be closed")
+	}
+	if fm.flavour != "chocolate" {
+		t.Errorf("expected flavour to be chocolate, got %q", fm.flavour)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	tm := teatest.NewTestModel(t, model{flavour: "vanilla"}, teatest.WithInitialTermSize(80, 24))
+
+	tm.Type("p")
+
+	waitForString(t, tm, "Pick a flavour")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// Cancelling keeps the previously picked value.
+	waitForString(t, tm, "Flavour: vanilla (cancelled)")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+	tm.WaitFinished(t)
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
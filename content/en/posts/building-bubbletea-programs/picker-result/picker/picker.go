@@ -0,0 +1,73 @@
+// Package picker provides a component for picking a value from a list.
+package picker
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+
+// PickedMsg is sent when the user picks a value.
+type PickedMsg struct {
+	Value string
+}
+
+// CancelledMsg is sent when the user closes the picker without picking a
+// value.
+type CancelledMsg struct{}
+
+// Model is a list of values to pick from. Rather than the parent inspecting
+// its state, it reports the outcome by returning a command that sends
+// either a PickedMsg or a CancelledMsg for the parent to handle.
+type Model struct {
+	title  string
+	values []string
+	cursor int
+}
+
+// New returns a picker for the given values.
+func New(title string, values ...string) Model {
+	return Model{title: title, values: values}
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = max(0, min(len(m.values)-1, m.cursor+1))
+		case "enter":
+			if len(m.values) == 0 {
+				return m, nil
+			}
+			value := m.values[m.cursor]
+			return m, func() tea.Msg {
+				return PickedMsg{Value: value}
+			}
+		case "esc":
+			return m, func() tea.Msg {
+				return CancelledMsg{}
+			}
+		}
+	}
+	return m, nil
+}
+
+func (m Model) View() string {
+	var b strings.Builder
+	b.WriteString(m.title + "\n\n")
+	for i, v := range m.values {
+		if i == m.cursor {
+			b.WriteString(cursorStyle.Render("> "+v) + "\n")
+		} else {
+			b.WriteString("  " + v + "\n")
+		}
+	}
+	b.WriteString("\nenter: pick • esc: cancel")
+	return b.String()
+}
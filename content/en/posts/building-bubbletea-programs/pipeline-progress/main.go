@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/progress"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	titleStyle   = lipgloss.NewStyle().Bold(true)
+	errorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	successStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+	faintStyle   = lipgloss.NewStyle().Faint(true)
+)
+
+// stage is one step of the pipeline. Run reports its progress, between 0
+// and 1, as it goes.
+type stage struct {
+	name string
+	run  func(report func(percent float64)) error
+}
+
+type state int
+
+const (
+	waiting state = iota
+	running
+	done
+	failed
+	skipped
+)
+
+type (
+	progressMsg struct {
+		stage   int
+		percent float64
+	}
+	stageDoneMsg struct {
+		stage int
+		err   error
+	}
+)
+
+type model struct {
+	stages  []stage
+	states  []state
+	percent []float64
+	err     error
+	// events carries progress from the stage that is running.
+	events chan tea.Msg
+	// ctx is cancelled on quit, so that the stage running in the background
+	// isn't left blocked sending to events once nobody is listening.
+	ctx    context.Context
+	cancel context.CancelFunc
+	bar    progress.Model
+}
+
+func newModel(stages ...stage) model {
+	ctx, cancel := context.WithCancel(context.Background())
+	m := model{
+		ctx:     ctx,
+		cancel:  cancel,
+		stages:  stages,
+		states:  make([]state, len(stages)),
+		percent: make([]float64, len(stages)),
+		events:  make(chan tea.Msg),
+		// The width includes the percentage, leaving 40 cells for the bar.
+		bar: progress.New(progress.WithDefaultGradient(), progress.WithWidth(45)),
+	}
+	// The first stage is started by Init.
+	m.states[0] = running
+	return m
+}
+
+func (m model) Init() tea.Cmd {
+	return m.start(0)
+}
+
+// start returns a command that runs the stage in the background,
+// forwarding its progress to the program one message at a time.
+func (m model) start(i int) tea.Cmd {
+	run, events, ctx := m.stages[i].run, m.events, m.ctx
+	return func() tea.Msg {
+		go func() {
+			send := func(msg tea.Msg) {
+				// Once cancelled, nobody is listening.
+				select {
+				case events <- msg:
+				case <-ctx.Done():
+				}
+			}
+			err := run(func(percent float64) {
+				send(progressMsg{stage: i, percent: percent})
+			})
+			send(stageDoneMsg{stage: i, err: err})
+		}()
+		return m.listen()
+	}
+}
+
+func (m model) listen() tea.Msg {
+	select {
+	case msg := <-m.events:
+		return msg
+	case <-m.ctx.Done():
+		return nil
+	}
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			m.cancel()
+			return m, tea.Quit
+		}
+	case progressMsg:
+		m.states[msg.stage] = running
+		m.percent[msg.stage] = max(0, min(1, msg.percent))
+		return m, m.listen
+	case stageDoneMsg:
+		if msg.err != nil {
+			m.states[msg.stage] = failed
+			m.err = msg.err
+			// There's nothing for the later stages to work with.
+			for i := msg.stage + 1; i < len(m.stages); i++ {
+				m.states[i] = skipped
+			}
+			return m, nil
+		}
+		// A stage that finishes without reporting any progress still
+		// counts as complete.
+		m.states[msg.stage] = done
+		m.percent[msg.stage] = 1
+		if next := msg.stage + 1; next < len(m.stages) {
+			m.states[next] = running
+			return m, m.start(next)
+		}
+		return m, nil
+	}
+	return m, nil
+}
+
+// overall is the progress of the whole pipeline, with each stage given
+// equal weight.
+func (m model) overall() float64 {
+	var total float64
+	for _, p := range m.percent {
+		total += p
+	}
+	return total / float64(len(m.stages))
+}
+
+func (m model) current() int {
+	for i, s := range m.states {
+		if s != done {
+			return i
+		}
+	}
+	return len(m.stages) - 1
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	current := m.current()
+	summary := fmt.Sprintf("overall %.0f%% • stage %d of %d: %s", m.overall()*100, current+1, len(m.stages), m.stages[current].name)
+	switch {
+	case m.err != nil:
+		summary = errorStyle.Render(fmt.Sprintf("pipeline failed at stage %d of %d: %s", current+1, len(m.stages), m.stages[current].name))
+	case m.states[len(m.stages)-1] == done:
+		summary = successStyle.Render("pipeline complete")
+	}
+	b.WriteString(titleStyle.Render(summary) + "\n\n")
+	for i, s := range m.stages {
+		var status string
+		switch m.states[i] {
+		case waiting:
+			status = faintStyle.Render("waiting")
+		case running:
+			status = "running"
+		case done:
+			status = successStyle.Render("done")
+		case failed:
+			status = errorStyle.Render("failed: " + m.err.Error())
+		case skipped:
+			status = faintStyle.Render("skipped")
+		}
+		fmt.Fprintf(&b, "%-10s %s  %s\n", s.name, m.bar.ViewAs(m.percent[i]), status)
+	}
+	b.WriteString("\nq: quit\n")
+	return b.String()
+}
+
+// simulate returns a stage that makes steady progress over the duration.
+func simulate(d time.Duration) func(func(float64)) error {
+	return func(report func(float64)) error {
+		const steps = 50
+		for i := 1; i <= steps; i++ {
+			time.Sleep(d / steps)
+			report(float64(i) / steps)
+		}
+		return nil
+	}
+}
+
+func main() {
+	m := newModel(
+		stage{name: "download", run: simulate(3 * time.Second)},
+		stage{name: "extract", run: simulate(2 * time.Second)},
+	)
+	p := tea.NewProgram(m)
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+// fake returns a stage that reports each update sent by the test, and
+// finishes with the error once the updates are closed. It closes started
+// when it begins, if given.
+func fake(updates <-chan float64, err error, started chan<- struct{}) func(func(float64)) error {
+	return func(report func(float64)) error {
+		if started != nil {
+			close(started)
+		}
+		for p := range updates {
+			report(p)
+		}
+		return err
+	}
+}
+
+func closed() chan float64 {
+	ch := make(chan float64)
+	close(ch)
+	return ch
+}
+
+func TestSecondStageWaitsForFirst(t *testing.T) {
+	download := make(chan float64)
+	extractStarted := make(chan struct{})
+	m := newModel(
+		stage{name: "download", run: fake(download, nil, nil)},
+		// Extract completes instantly, without reporting any progress.
+		stage{name: "extract", run: fake(closed(), nil, extractStarted)},
+	)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(100, 24))
+
+	empty := strings.Repeat("░", 40)
+	waitForStrings(t, tm, "overall 0% • stage 1 of 2: download", "extract    "+empty+"   0%  waiting")
+
+	download <- 0.5
+
+	waitForStrings(t, tm, "overall 25% • stage 1 of 2: download", "download   "+strings.Repeat("█", 20)+strings.Repeat("░", 20)+"  50%  running")
+
+	download <- 1
+
+	waitForStrings(t, tm, "overall 50% • stage 1 of 2: download")
+
+	select {
+	case <-extractStarted:
+		t.Fatal("expected extract to wait until download has finished")
+	default:
+	}
+
+	close(download)
+
+	waitForStrings(t, tm, "pipeline complete", "extract    "+strings.Repeat("█", 40)+" 100%  done")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	for i, s := range fm.states {
+		if s != done || fm.percent[i] != 1 {
+			t.Errorf("expected stage %d to be done, got state %d at %.0f%%", i, s, fm.percent[i]*100)
+		}
+	}
+}
+
+func TestFirstStageFails(t *testing.T) {
+	download := make(chan float64)
+	extractStarted := make(chan struct{})
+	m := newModel(
+		stage{name: "download", run: fake(download, errors.New("connection reset"), nil)},
+		stage{name: "extract", run: fake(closed(), nil, extractStarted)},
+	)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(100, 24))
+
+	download <- 0.3
+	close(download)
+
+	waitForStrings(t, tm, "pipeline failed at stage 1 of 2: download", "30%  failed: connection reset", "  0%  skipped")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.states[1] != skipped {
+		t.Errorf("expected extract to be skipped, got state %d", fm.states[1])
+	}
+	select {
+	case <-extractStarted:
+		t.Error("expected extract not to start")
+	default:
+	}
+}
+
+func TestQuitMidStage(t *testing.T) {
+	download := make(chan float64)
+	returned := make(chan struct{})
+	run := func(report func(float64)) error {
+		defer close(returned)
+		for p := range download {
+			report(p)
+		}
+		return nil
+	}
+	tm := teatest.NewTestModel(t, newModel(stage{name: "download", run: run}), teatest.WithInitialTermSize(100, 24))
+
+	download <- 0.5
+
+	waitForStrings(t, tm, "overall 50%")
+
+	tm.Type("q")
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+
+	// With nobody listening, the stage's progress is dropped rather than
+	// blocking it forever.
+	for _, p := range []float64{0.6, 0.7, 0.8} {
+		select {
+		case download <- p:
+		case <-time.After(time.Second):
+			t.Fatal("expected stage to keep running after quitting")
+		}
+	}
+	close(download)
+	select {
+	case <-returned:
+	case <-time.After(time.Second):
+		t.Fatal("expected stage to return after quitting")
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
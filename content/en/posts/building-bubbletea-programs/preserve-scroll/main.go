@@ -0,0 +1,151 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+
+type item struct {
+	id    int
+	name  string
+	value int
+}
+
+type loadedMsg struct{ items []item }
+
+type model struct {
+	load     func() []item
+	items    []item
+	cursor   int
+	viewport viewport.Model
+	ready    bool
+	reloads  int
+}
+
+func newModel(load func() []item) model {
+	return model{load: load, items: load()}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = max(0, min(len(m.items)-1, m.cursor+1))
+		case "r":
+			load := m.load
+			return m, func() tea.Msg {
+				return loadedMsg{items: load()}
+			}
+		}
+		m.render()
+		return m, nil
+	case tea.WindowSizeMsg:
+		if !m.ready {
+			m.viewport = viewport.New(msg.Width, msg.Height-2)
+			m.ready = true
+		} else {
+			m.viewport.Width = msg.Width
+			m.viewport.Height = msg.Height - 2
+		}
+		m.render()
+	case loadedMsg:
+		m.reloads++
+		m.reload(msg.items)
+	}
+	return m, nil
+}
+
+// reload replaces the items, keeping the focused item under the cursor, and
+// on the same row of the screen, if it still exists.
+func (m *model) reload(items []item) {
+	row := m.cursor - m.viewport.YOffset
+	cursor := min(m.cursor, len(items)-1)
+	if m.cursor < len(m.items) {
+		id := m.items[m.cursor].id
+		for i, it := range items {
+			if it.id == id {
+				cursor = i
+				break
+			}
+		}
+	}
+	m.items = items
+	m.cursor = max(0, cursor)
+	m.render()
+	m.viewport.SetYOffset(m.cursor - row)
+	m.render()
+}
+
+// render updates the viewport's content and scrolls to keep the cursor in
+// view.
+func (m *model) render() {
+	if !m.ready {
+		return
+	}
+	lines := make([]string, len(m.items))
+	for i, it := range m.items {
+		line := fmt.Sprintf("%-10s %5d", it.name, it.value)
+		if i == m.cursor {
+			lines[i] = cursorStyle.Render("> " + line)
+		} else {
+			lines[i] = "  " + line
+		}
+	}
+	if len(lines) == 0 {
+		lines = []string{"(no items)"}
+	}
+	m.viewport.SetContent(strings.Join(lines, "\n"))
+
+	switch {
+	case m.cursor < m.viewport.YOffset:
+		m.viewport.SetYOffset(m.cursor)
+	case m.cursor >= m.viewport.YOffset+m.viewport.Height:
+		m.viewport.SetYOffset(m.cursor - m.viewport.Height + 1)
+	}
+}
+
+func (m model) View() string {
+	if !m.ready {
+		return "loading..."
+	}
+	return fmt.Sprintf("%s\n\noffset: %d • cursor: %d • reloads: %d • r: reload • q: quit",
+		m.viewport.View(), m.viewport.YOffset, m.cursor, m.reloads)
+}
+
+// load simulates fetching items from an API, which returns the same items
+// with new values, occasionally dropping or adding an item.
+func load() []item {
+	var items []item
+	for id := 1; id <= 100; id++ {
+		if rand.Intn(10) == 0 {
+			continue
+		}
+		items = append(items, item{id: id, name: fmt.Sprintf("item %03d", id), value: rand.Intn(1000)})
+	}
+	return items
+}
+
+func main() {
+	p := tea.NewProgram(newModel(load), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
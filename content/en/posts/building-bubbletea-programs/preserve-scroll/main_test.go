@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func fixedItems(ids ...int) []item {
+	items := make([]item, len(ids))
+	for i, id := range ids {
+		items[i] = item{id: id, name: fmt.Sprintf("item %03d", id)}
+	}
+	return items
+}
+
+func seq(from, to int) []int {
+	var ids []int
+	for i := from; i <= to; i++ {
+		ids = append(ids, i)
+	}
+	return ids
+}
+
+func TestReloadPreservesScroll(t *testing.T) {
+	m := newModel(func() []item { return fixedItems(seq(1, 50)...) })
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 12))
+
+	for range 20 {
+		tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	}
+
+	waitForString(t, tm, "offset: 11 • cursor: 20 • reloads: 0")
+
+	tm.Type("r")
+
+	waitForString(t, tm, "offset: 11 • cursor: 20 • reloads: 1")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestReloadFollowsID(t *testing.T) {
+	loads := [][]item{
+		fixedItems(seq(1, 50)...),
+		// Two items inserted above the focused item.
+		fixedItems(append([]int{100, 101}, seq(1, 50)...)...),
+	}
+	m := newModel(func() []item {
+		items := loads[0]
+		loads = loads[1:]
+		return items
+	})
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 12))
+
+	for range 20 {
+		tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	waitForString(t, tm, "offset: 11 • cursor: 20 • reloads: 0")
+
+	tm.Type("r")
+
+	waitForString(t, tm, "offset: 13 • cursor: 22 • reloads: 1")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.items[fm.cursor].id; got != 21 {
+		t.Errorf("expected cursor to stay on item 21, got %d", got)
+	}
+}
+
+func TestReloadShrinks(t *testing.T) {
+	loads := [][]item{
+		fixedItems(seq(1, 50)...),
+		fixedItems(1, 2, 3, 4, 5),
+	}
+	m := newModel(func() []item {
+		items := loads[0]
+		loads = loads[1:]
+		return items
+	})
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 12))
+
+	for range 20 {
+		tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	waitForString(t, tm, "cursor: 20 • reloads: 0")
+
+	// The focused item no longer exists, so the cursor falls back to the
+	// nearest index.
+	tm.Type("r")
+
+	waitForString(t, tm, "offset: 0 • cursor: 4 • reloads: 1")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
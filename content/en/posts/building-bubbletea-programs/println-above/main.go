@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var doneStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+
+type eventMsg struct {
+	name     string
+	duration time.Duration
+}
+
+type model struct {
+	interval  time.Duration
+	spinner   spinner.Model
+	processed int
+	current   string
+}
+
+func newModel(interval time.Duration) model {
+	return model{interval: interval, spinner: spinner.New()}
+}
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, m.next())
+}
+
+// next simulates an event occurring after the interval.
+func (m model) next() tea.Cmd {
+	n := m.processed + 1
+	return tea.Tick(m.interval, func(time.Time) tea.Msg {
+		return eventMsg{
+			name:     fmt.Sprintf("job-%d", n),
+			duration: time.Duration(rand.Intn(1000)) * time.Millisecond,
+		}
+	})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+	case eventMsg:
+		m.processed++
+		m.current = msg.name
+		// The line is formatted here, from the message, rather than from
+		// the model when the command runs: by then the model may have
+		// moved on. The program prints it above the UI before the next
+		// frame is rendered, so the two never interleave.
+		line := fmt.Sprintf("%s %s finished in %s", doneStyle.Render("✓"), msg.name, msg.duration)
+		return m, tea.Batch(tea.Println(line), m.next())
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	current := m.current
+	if current == "" {
+		current = "waiting"
+	}
+	return fmt.Sprintf("%s processed %d events • last: %s • q: quit", m.spinner.View(), m.processed, current)
+}
+
+func main() {
+	// Printing above the UI only works outside the alt screen.
+	p := tea.NewProgram(newModel(500 * time.Millisecond))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
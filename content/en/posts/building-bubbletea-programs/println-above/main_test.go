@@ -0,0 +1,52 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestPrintln(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(time.Hour), teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "processed 0 events")
+
+	tm.Send(eventMsg{name: "build", duration: time.Second})
+	tm.Send(eventMsg{name: "test", duration: 2 * time.Second})
+	tm.Send(eventMsg{name: "deploy", duration: 3 * time.Second})
+
+	// The printed lines and the live UI are both written to the output.
+	waitForStrings(t, tm,
+		"build finished in 1s",
+		"test finished in 2s",
+		"deploy finished in 3s",
+		"processed 3 events • last: deploy",
+	)
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+	tm.WaitFinished(t)
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	waitForStrings(t, tm, s)
+}
+
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
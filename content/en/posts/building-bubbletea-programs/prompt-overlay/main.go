@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"unordered/prompt"
+)
+
+var (
+	cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	descStyle   = lipgloss.NewStyle().Faint(true)
+)
+
+type file struct {
+	name, description string
+}
+
+type model struct {
+	files  []file
+	cursor int
+	status string
+
+	prompt    prompt.Model
+	prompting bool
+	// nextID is the ID of the next prompt opened. The IDs of the open
+	// rename and describe prompts, if any, say what an answer is for.
+	nextID               int
+	renameID, describeID int
+	// target is the index of the file the open prompt is about.
+	target int
+}
+
+func newModel(files []file) model {
+	return model{files: files, nextID: 1}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+	case prompt.AnsweredMsg:
+		m.prompting = false
+		switch msg.ID {
+		case m.renameID:
+			m.status = fmt.Sprintf("renamed %s to %s", m.files[m.target].name, msg.Value)
+			m.files[m.target].name = msg.Value
+		case m.describeID:
+			// The description is optional, so an empty answer removes it.
+			m.files[m.target].description = msg.Value
+			m.status = "updated description of " + m.files[m.target].name
+		}
+		m.renameID, m.describeID = 0, 0
+		return m, nil
+	case prompt.CancelledMsg:
+		m.prompting = false
+		m.renameID, m.describeID = 0, 0
+		m.status = "cancelled"
+		return m, nil
+	}
+
+	if m.prompting {
+		var cmd tea.Cmd
+		m.prompt, cmd = m.prompt.Update(msg)
+		return m, cmd
+	}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = min(len(m.files)-1, m.cursor+1)
+		case "r":
+			m.renameID = m.nextID
+			return m, m.open(m.renameID, "Rename to:", m.files[m.cursor].name, true)
+		case "d":
+			m.describeID = m.nextID
+			return m, m.open(m.describeID, "Description:", m.files[m.cursor].description, false)
+		}
+	}
+	return m, nil
+}
+
+func (m *model) open(id int, question, value string, required bool) tea.Cmd {
+	m.nextID++
+	m.target = m.cursor
+	m.status = ""
+	m.prompt = prompt.New(id, question, value)
+	m.prompt.SetRequired(required)
+	m.prompting = true
+	return m.prompt.Focus()
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	for i, f := range m.files {
+		line := f.name
+		if f.description != "" {
+			line += " " + descStyle.Render("— "+f.description)
+		}
+		if i == m.cursor {
+			b.WriteString(cursorStyle.Render("> ") + line + "\n")
+		} else {
+			b.WriteString("  " + line + "\n")
+		}
+	}
+	b.WriteString("\n")
+	if m.prompting {
+		b.WriteString(m.prompt.View() + "\n")
+	} else {
+		if m.status != "" {
+			b.WriteString(m.status + "\n")
+		}
+		b.WriteString(descStyle.Render("r: rename • d: describe • q: quit") + "\n")
+	}
+	return b.String()
+}
+
+func main() {
+	files := []file{
+		{name: "notes.txt"},
+		{name: "report.pdf", description: "quarterly numbers"},
+		{name: "photo.jpg"},
+	}
+	p := tea.NewProgram(newModel(files))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func testFiles() []file {
+	return []file{
+		{name: "notes.txt"},
+		{name: "report.pdf", description: "quarterly numbers"},
+	}
+}
+
+func TestRename(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(testFiles()), teatest.WithInitialTermSize(80, 20))
+
+	tm.Type("jr")
+
+	waitForString(t, tm, "Rename to:")
+
+	// Clear the current name, and try to submit nothing.
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlU})
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "an answer is required")
+
+	tm.Type("summary.pdf")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "renamed report.pdf to summary.pdf")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.files[1].name; got != "summary.pdf" {
+		t.Errorf("expected file to be renamed, got %q", got)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(testFiles()), teatest.WithInitialTermSize(80, 20))
+
+	tm.Type("r")
+
+	waitForString(t, tm, "Rename to:")
+
+	tm.Type("-old")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+
+	waitForString(t, tm, "cancelled")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.files[0].name; got != "notes.txt" {
+		t.Errorf("expected name to be unchanged, got %q", got)
+	}
+}
+
+func TestEmptyDescription(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(testFiles()), teatest.WithInitialTermSize(80, 20))
+
+	// Unlike a name, a description can be empty.
+	tm.Type("jd")
+
+	waitForString(t, tm, "Description:")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlU})
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "updated description of report.pdf")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.files[1].description; got != "" {
+		t.Errorf("expected description to be removed, got %q", got)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,99 @@
+// Package prompt provides a component that asks a question and reports the
+// answer.
+package prompt
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	boxStyle   = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color("205")).Padding(0, 1)
+	errorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	helpStyle  = lipgloss.NewStyle().Faint(true)
+)
+
+// AnsweredMsg is sent when the user submits an answer to the prompt with
+// the given ID.
+type AnsweredMsg struct {
+	ID    int
+	Value string
+}
+
+// CancelledMsg is sent when the user closes the prompt with the given ID
+// without answering.
+type CancelledMsg struct {
+	ID int
+}
+
+// Model asks a question. The parent gives each prompt an ID, and matches
+// the AnsweredMsg or CancelledMsg it returns by that ID, so that it knows
+// what the answer is for.
+type Model struct {
+	id       int
+	question string
+	input    textinput.Model
+	required bool
+	err      string
+}
+
+// New returns a prompt asking the question, with the answer starting out
+// as value.
+func New(id int, question, value string) Model {
+	input := textinput.New()
+	input.Prompt = ""
+	input.SetValue(value)
+	input.CursorEnd()
+	input.Width = 30
+	return Model{id: id, question: question, input: input}
+}
+
+// SetRequired sets whether the answer can be empty. If required, an empty
+// answer isn't submitted, and the prompt stays open.
+func (m *Model) SetRequired(required bool) {
+	m.required = required
+}
+
+// Focus focuses the prompt's input.
+func (m *Model) Focus() tea.Cmd {
+	return m.input.Focus()
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "enter":
+			value := strings.TrimSpace(m.input.Value())
+			if value == "" && m.required {
+				m.err = "an answer is required"
+				return m, nil
+			}
+			id := m.id
+			return m, func() tea.Msg {
+				return AnsweredMsg{ID: id, Value: value}
+			}
+		case "esc":
+			id := m.id
+			return m, func() tea.Msg {
+				return CancelledMsg{ID: id}
+			}
+		}
+		m.err = ""
+	}
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+func (m Model) View() string {
+	lines := []string{m.question, m.input.View()}
+	if m.err != "" {
+		lines = append(lines, errorStyle.Render(m.err))
+	}
+	lines = append(lines, helpStyle.Render("enter: submit • esc: cancel"))
+	return boxStyle.Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	cursorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	selectedStyle = lipgloss.NewStyle().Background(lipgloss.Color("237"))
+	statusStyle   = lipgloss.NewStyle().Faint(true)
+)
+
+type model struct {
+	items  []string
+	cursor int
+	// anchor is where the selection started, or -1 if there is no
+	// selection. The selection runs from the anchor to the cursor, in
+	// either direction.
+	anchor    int
+	clipboard []string
+	status    string
+}
+
+func newModel(items []string) model {
+	return model{items: items, anchor: -1}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+// selection returns the first and last selected items. Without a
+// selection, it's just the item under the cursor.
+func (m model) selection() (int, int) {
+	if m.anchor < 0 {
+		return m.cursor, m.cursor
+	}
+	return min(m.anchor, m.cursor), max(m.anchor, m.cursor)
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		m.status = ""
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.anchor = -1
+			m.move(-1)
+		case "down", "j":
+			m.anchor = -1
+			m.move(1)
+		case "shift+up", "K":
+			m.extend(-1)
+		case "shift+down", "J":
+			m.extend(1)
+		case "ctrl+a":
+			if len(m.items) > 0 {
+				m.anchor, m.cursor = 0, len(m.items)-1
+			}
+		case "esc":
+			m.anchor = -1
+		case "d":
+			m.delete()
+		case "y":
+			if len(m.items) > 0 {
+				lo, hi := m.selection()
+				m.clipboard = slices.Clone(m.items[lo : hi+1])
+				m.status = "copied " + count(len(m.clipboard))
+			}
+		case "p":
+			m.paste()
+		}
+	}
+	return m, nil
+}
+
+func (m *model) move(delta int) {
+	m.cursor = max(0, min(len(m.items)-1, m.cursor+delta))
+}
+
+// extend moves the cursor, starting a selection at the current item if
+// there isn't one already. Moving back past the anchor selects the items
+// on the other side of it instead.
+func (m *model) extend(delta int) {
+	if len(m.items) == 0 {
+		return
+	}
+	if m.anchor < 0 {
+		m.anchor = m.cursor
+	}
+	m.move(delta)
+}
+
+func (m *model) delete() {
+	if len(m.items) == 0 {
+		return
+	}
+	lo, hi := m.selection()
+	m.items = slices.Delete(m.items, lo, hi+1)
+	m.status = "deleted " + count(hi-lo+1)
+	m.anchor = -1
+	m.cursor = max(0, min(lo, len(m.items)-1))
+}
+
+// paste inserts the copied items after the cursor, and selects them.
+func (m *model) paste() {
+	if len(m.clipboard) == 0 {
+		return
+	}
+	at := min(len(m.items), m.cursor+1)
+	m.items = slices.Insert(m.items, at, m.clipboard...)
+	m.anchor, m.cursor = at, at+len(m.clipboard)-1
+	m.status = "pasted " + count(len(m.clipboard))
+}
+
+func count(n int) string {
+	if n == 1 {
+		return "1 item"
+	}
+	return fmt.Sprintf("%d items", n)
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	if len(m.items) == 0 {
+		b.WriteString("  no items\n")
+	}
+	lo, hi := m.selection()
+	for i, item := range m.items {
+		line := "  " + item
+		if i == m.cursor {
+			line = cursorStyle.Render("> " + item)
+		}
+		if m.anchor >= 0 && i >= lo && i <= hi {
+			line = selectedStyle.Render(line)
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("\n")
+	if m.anchor >= 0 {
+		fmt.Fprintf(&b, "%d selected • ", hi-lo+1)
+	}
+	if m.status != "" {
+		b.WriteString(m.status + " • ")
+	}
+	b.WriteString(statusStyle.Render("shift+↑/↓: select • ctrl+a: all • d: delete • y: copy • p: paste • q: quit") + "\n")
+	return b.String()
+}
+
+func main() {
+	items := []string{"apples", "bananas", "cherries", "dates", "elderberries", "figs", "grapes", "honeydew"}
+	p := tea.NewProgram(newModel(items))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+var items = []string{"one", "two", "three", "four", "five", "six"}
+
+func TestBulkDelete(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(slices.Clone(items)), teatest.WithInitialTermSize(120, 24))
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeyShiftDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeyShiftDown})
+
+	waitForString(t, tm, "3 selected")
+
+	tm.Type("d")
+
+	waitForString(t, tm, "deleted 3 items")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if want := []string{"one", "five", "six"}; !slices.Equal(fm.items, want) {
+		t.Errorf("want items %v, got %v", want, fm.items)
+	}
+	if fm.cursor != 1 {
+		t.Errorf("expected cursor on the item after the deleted range, got %d", fm.cursor)
+	}
+}
+
+func TestExtend(t *testing.T) {
+	m := newModel(slices.Clone(items))
+	m.cursor = 2
+	send := func(keys ...tea.KeyType) {
+		for _, k := range keys {
+			updated, _ := m.Update(tea.KeyMsg{Type: k})
+			m = updated.(model)
+		}
+	}
+
+	// Down two from the anchor, then back up past it.
+	send(tea.KeyShiftDown, tea.KeyShiftDown, tea.KeyShiftUp, tea.KeyShiftUp, tea.KeyShiftUp)
+	if lo, hi := m.selection(); lo != 1 || hi != 2 {
+		t.Errorf("expected selection to flip above the anchor, got %d-%d", lo, hi)
+	}
+
+	// Extending beyond the top stops at the first item.
+	send(tea.KeyShiftUp, tea.KeyShiftUp, tea.KeyShiftUp)
+	if lo, hi := m.selection(); lo != 0 || hi != 2 {
+		t.Errorf("expected selection to stop at the top, got %d-%d", lo, hi)
+	}
+
+	send(tea.KeyCtrlA)
+	if lo, hi := m.selection(); lo != 0 || hi != len(items)-1 {
+		t.Errorf("expected everything to be selected, got %d-%d", lo, hi)
+	}
+
+	// Plain movement drops the selection.
+	send(tea.KeyUp)
+	if m.anchor != -1 {
+		t.Errorf("expected selection to be cleared, got anchor %d", m.anchor)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
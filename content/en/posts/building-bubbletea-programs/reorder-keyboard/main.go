@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	helpStyle   = lipgloss.NewStyle().Faint(true)
+)
+
+type model struct {
+	items []string
+	// cursor is an index into the visible items, not into items.
+	cursor int
+
+	filter    textinput.Model
+	filtering bool
+}
+
+func newModel(items []string) model {
+	filter := textinput.New()
+	filter.Prompt = "/"
+	return model{items: items, filter: filter}
+}
+
+// visible returns the indices of the items matching the filter, in their
+// underlying order.
+func (m model) visible() []int {
+	var indices []int
+	for i, item := range m.items {
+		if strings.Contains(item, m.filter.Value()) {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// move moves the selected item by delta places among the visible items,
+// keeping it selected. While filtered, the item swaps places with its
+// visible neighbour in the underlying list, leaving the hidden items where
+// they are. Moving the top item up or the bottom item down does nothing.
+func (m *model) move(delta int) {
+	visible := m.visible()
+	to := m.cursor + delta
+	if m.cursor >= len(visible) || to < 0 || to >= len(visible) {
+		return
+	}
+	i, j := visible[m.cursor], visible[to]
+	m.items[i], m.items[j] = m.items[j], m.items[i]
+	m.cursor = to
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.filtering {
+			return m.updateFilter(msg)
+		}
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = max(0, min(len(m.visible())-1, m.cursor+1))
+		case "shift+up", "K":
+			m.move(-1)
+		case "shift+down", "J":
+			m.move(1)
+		case "/":
+			m.filtering = true
+			return m, m.filter.Focus()
+		case "esc":
+			m.filter.SetValue("")
+			m.cursor = 0
+		}
+	}
+	return m, nil
+}
+
+func (m model) updateFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		m.filter.SetValue("")
+		fallthrough
+	case "enter":
+		m.filtering = false
+		m.filter.Blur()
+		m.cursor = 0
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.filter, cmd = m.filter.Update(msg)
+	m.cursor = 0
+	return m, cmd
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	for i, idx := range m.visible() {
+		if i == m.cursor {
+			b.WriteString(cursorStyle.Render("> " + m.items[idx]))
+		} else {
+			b.WriteString("  " + m.items[idx])
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	if m.filtering || m.filter.Value() != "" {
+		b.WriteString(m.filter.View() + "\n")
+	}
+	b.WriteString(helpStyle.Render("j/k: select • J/K or shift+↑/↓: move • /: filter • esc: clear filter • q: quit") + "\n")
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel([]string{"bread", "butter", "milk", "eggs", "brie", "jam"}))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func items() []string {
+	return []string{"bread", "butter", "milk", "eggs", "brie", "jam"}
+}
+
+func TestMoveDown(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(items()), teatest.WithInitialTermSize(80, 20))
+
+	waitForStrings(t, tm, "> bread")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyShiftDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeyShiftDown})
+
+	waitForStrings(t, tm, "  butter", "  milk", "> bread")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	want := []string{"butter", "milk", "bread", "eggs", "brie", "jam"}
+	if !slices.Equal(fm.items, want) {
+		t.Errorf("want %v, got %v", want, fm.items)
+	}
+	if fm.cursor != 2 {
+		t.Errorf("expected the moved item to stay selected, got cursor %d", fm.cursor)
+	}
+}
+
+func TestMovePastEnds(t *testing.T) {
+	m := newModel(items())
+
+	m.move(-1)
+	if !slices.Equal(m.items, items()) || m.cursor != 0 {
+		t.Errorf("expected moving the top item up to do nothing, got %v at %d", m.items, m.cursor)
+	}
+
+	m.cursor = len(m.items) - 1
+	m.move(1)
+	if !slices.Equal(m.items, items()) || m.cursor != len(m.items)-1 {
+		t.Errorf("expected moving the bottom item down to do nothing, got %v at %d", m.items, m.cursor)
+	}
+}
+
+func TestMoveFiltered(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(items()), teatest.WithInitialTermSize(80, 20))
+
+	tm.Type("/br")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForStrings(t, tm, "> bread", "  brie")
+
+	// bread swaps places with brie, skipping over the hidden items.
+	tm.Type("J")
+
+	waitForStrings(t, tm, "  brie", "> bread")
+
+	// bread is now the bottom visible item.
+	tm.Type("J")
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	want := []string{"brie", "butter", "milk", "eggs", "bread", "jam"}
+	if !slices.Equal(fm.items, want) {
+		t.Errorf("want %v, got %v", want, fm.items)
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
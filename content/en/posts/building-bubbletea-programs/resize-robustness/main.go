@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/term"
+)
+
+// The size to assume when the real size can't be determined.
+const (
+	defaultWidth  = 80
+	defaultHeight = 24
+)
+
+var (
+	headerStyle = lipgloss.NewStyle().Bold(true).Reverse(true).Align(lipgloss.Center)
+	bodyStyle   = lipgloss.NewStyle().Align(lipgloss.Center, lipgloss.Center)
+)
+
+// sizeMsg reports the result of querying the terminal size at startup.
+type sizeMsg struct {
+	width, height int
+	err           error
+}
+
+type model struct {
+	// getSize returns the terminal's size. Tests replace it to simulate
+	// failure.
+	getSize       func() (int, int, error)
+	width, height int
+	source        string
+}
+
+func newModel(getSize func() (int, int, error)) model {
+	// Start with the defaults rather than zero, so that View never lays
+	// out a zero-sized screen, however long the real size takes to
+	// arrive, or if it never does.
+	return model{
+		getSize: getSize,
+		width:   defaultWidth,
+		height:  defaultHeight,
+		source:  "default",
+	}
+}
+
+// Init queries the terminal's size. Bubble Tea only sends a
+// tea.WindowSizeMsg when its output is a terminal, so when output is piped
+// or redirected, no size message would ever arrive.
+func (m model) Init() tea.Cmd {
+	getSize := m.getSize
+	return func() tea.Msg {
+		w, h, err := getSize()
+		return sizeMsg{width: w, height: h, err: err}
+	}
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case sizeMsg:
+		if msg.err != nil || msg.width <= 0 || msg.height <= 0 {
+			// Keep the defaults.
+			return m, nil
+		}
+		if m.source == "resize" {
+			// The query raced a tea.WindowSizeMsg, which is the more
+			// recent size.
+			return m, nil
+		}
+		m.width, m.height, m.source = msg.width, msg.height, "terminal"
+	case tea.WindowSizeMsg:
+		// Some environments report a zero size; ignore it rather than
+		// collapse the layout.
+		if msg.Width > 0 && msg.Height > 0 {
+			m.width, m.height, m.source = msg.Width, msg.Height, "resize"
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	header := headerStyle.Width(m.width).Render("resize robustness")
+	body := bodyStyle.
+		Width(m.width).
+		Height(m.height - 2).
+		Render(fmt.Sprintf("size: %dx%d (%s)", m.width, m.height, m.source))
+	footer := lipgloss.NewStyle().Width(m.width).Render("q: quit")
+	return lipgloss.JoinVertical(lipgloss.Left, header, body, footer)
+}
+
+// terminalSize returns the size of the terminal attached to stdout, or an
+// error if stdout isn't a terminal.
+func terminalSize() (int, int, error) {
+	return term.GetSize(os.Stdout.Fd())
+}
+
+func main() {
+	p := tea.NewProgram(newModel(terminalSize))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
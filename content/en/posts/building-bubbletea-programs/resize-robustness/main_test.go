@@ -0,0 +1,71 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestNoTTY(t *testing.T) {
+	getSize := func() (int, int, error) {
+		return 0, 0, errors.New("inappropriate ioctl for device")
+	}
+	// No initial size: teatest doesn't run in a terminal, so no
+	// tea.WindowSizeMsg is sent.
+	tm := teatest.NewTestModel(t, newModel(getSize))
+
+	waitForString(t, tm, "size: 80x24 (default)")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	lines := strings.Split(fm.View(), "\n")
+	if len(lines) != defaultHeight {
+		t.Errorf("expected %d lines, got %d", defaultHeight, len(lines))
+	}
+	for i, line := range lines {
+		if w := lipgloss.Width(line); w != defaultWidth {
+			t.Errorf("line %d: expected width %d, got %d", i, defaultWidth, w)
+		}
+	}
+}
+
+func TestGetSize(t *testing.T) {
+	getSize := func() (int, int, error) {
+		return 100, 30, nil
+	}
+	tm := teatest.NewTestModel(t, newModel(getSize))
+
+	waitForString(t, tm, "size: 100x30 (terminal)")
+
+	tm.Type("q")
+	tm.WaitFinished(t)
+}
+
+func TestSizeAfterResize(t *testing.T) {
+	var m tea.Model = newModel(nil)
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	// The startup query finishes after the resize.
+	m, _ = m.Update(sizeMsg{width: 100, height: 30})
+
+	if fm := m.(model); fm.width != 120 || fm.height != 40 || fm.source != "resize" {
+		t.Errorf("expected 120x40 (resize), got %dx%d (%s)", fm.width, fm.height, fm.source)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/shirou/gopsutil/v4/cpu"
+	"github.com/shirou/gopsutil/v4/mem"
+)
+
+var sparks = []rune("▁▂▃▄▅▆▇█")
+
+var (
+	labelStyle = lipgloss.NewStyle().Bold(true)
+	cpuStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("39"))
+	memStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("170"))
+)
+
+type sample struct {
+	cpu, mem float64
+}
+
+type sampler interface {
+	Sample() (sample, error)
+}
+
+type systemSampler struct{}
+
+func (systemSampler) Sample() (sample, error) {
+	cpus, err := cpu.Percent(0, false)
+	if err != nil {
+		return sample{}, err
+	}
+	vm, err := mem.VirtualMemory()
+	if err != nil {
+		return sample{}, err
+	}
+	return sample{cpu: cpus[0], mem: vm.UsedPercent}, nil
+}
+
+type (
+	sampleMsg sample
+	tickMsg   struct{}
+	errMsg    struct{ err error }
+)
+
+type model struct {
+	sampler  sampler
+	interval time.Duration
+	history  []sample
+	width    int
+	err      error
+}
+
+func newModel(s sampler, interval time.Duration) model {
+	return model{sampler: s, interval: interval, width: 60}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.sample
+}
+
+func (m model) sample() tea.Msg {
+	s, err := m.sampler.Sample()
+	if err != nil {
+		return errMsg{err}
+	}
+	return sampleMsg(s)
+}
+
+func (m model) tick() tea.Cmd {
+	return tea.Tick(m.interval, func(time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.history = m.trim(m.history)
+	case tickMsg:
+		return m, m.sample
+	case sampleMsg:
+		m.err = nil
+		m.history = m.trim(append(m.history, sample(msg)))
+		return m, m.tick()
+	case errMsg:
+		m.err = msg.err
+		return m, m.tick()
+	}
+	return m, nil
+}
+
+// trim drops the oldest samples that no longer fit in the graph.
+func (m model) trim(history []sample) []sample {
+	if len(history) > m.width {
+		return history[len(history)-m.width:]
+	}
+	return history
+}
+
+func (m model) View() string {
+	if len(m.history) == 0 {
+		return "collecting first sample..."
+	}
+	cpus := make([]float64, len(m.history))
+	mems := make([]float64, len(m.history))
+	for i, s := range m.history {
+		cpus[i] = s.cpu
+		mems[i] = s.mem
+	}
+	last := m.history[len(m.history)-1]
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %5.1f%%\n%s\n\n", labelStyle.Render("CPU"), last.cpu, cpuStyle.Render(sparkline(cpus)))
+	fmt.Fprintf(&b, "%s %5.1f%%\n%s\n", labelStyle.Render("MEM"), last.mem, memStyle.Render(sparkline(mems)))
+	if m.err != nil {
+		fmt.Fprintf(&b, "\nerror: %s\n", m.err)
+	}
+	return b.String()
+}
+
+// sparkline renders percentages as a row of block characters.
+func sparkline(values []float64) string {
+	var b strings.Builder
+	for _, v := range values {
+		i := int(v / 100 * float64(len(sparks)))
+		b.WriteRune(sparks[max(0, min(len(sparks)-1, i))])
+	}
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel(systemSampler{}, time.Second))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
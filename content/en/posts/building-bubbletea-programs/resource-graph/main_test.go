@@ -0,0 +1,56 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+// risingSampler returns values that increase by a fixed step on each sample,
+// capped at 100%.
+type risingSampler struct {
+	step float64
+	n    int
+}
+
+func (s *risingSampler) Sample() (sample, error) {
+	s.n++
+	v := min(100, float64(s.n)*s.step)
+	return sample{cpu: v, mem: v / 2}, nil
+}
+
+func TestRising(t *testing.T) {
+	m := newModel(&risingSampler{step: 12.5}, 10*time.Millisecond)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "▂▃▄▅▆▇██")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	for i := 1; i < len(fm.history); i++ {
+		if fm.history[i].cpu < fm.history[i-1].cpu {
+			t.Errorf("expected rising values, got %v", fm.history)
+		}
+	}
+}
+
+func TestFirstSample(t *testing.T) {
+	if got := newModel(&risingSampler{}, time.Second).View(); got != "collecting first sample..." {
+		t.Errorf("unexpected view before first sample: %q", got)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
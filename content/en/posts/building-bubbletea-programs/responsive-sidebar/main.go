@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// breakpoint is the narrowest terminal with room for the sidebar
+	// alongside the content.
+	breakpoint   = 80
+	sidebarWidth = 24
+)
+
+var (
+	sidebarStyle = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+	contentStyle = lipgloss.NewStyle().Border(lipgloss.NormalBorder()).Padding(0, 1)
+	activeStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	footerStyle  = lipgloss.NewStyle().Faint(true)
+)
+
+var sections = []string{"Overview", "Installation", "Configuration", "Commands", "Troubleshooting", "FAQ"}
+
+const text = "The sidebar sits alongside the content when the terminal is wide enough. " +
+	"Below the breakpoint it is hidden, the content takes the full width, " +
+	"and the sidebar can instead be opened on top of the content."
+
+type model struct {
+	width, height int
+	current       int
+	// open is whether the sidebar is shown on top of the content, which
+	// only applies below the breakpoint.
+	open bool
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+// docked reports whether the sidebar is shown alongside the content.
+func (m model) docked() bool {
+	return m.width >= breakpoint
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "s":
+			if !m.docked() {
+				m.open = !m.open
+			}
+		case "esc":
+			m.open = false
+		case "up", "k":
+			m.current = max(0, m.current-1)
+		case "down", "j":
+			m.current = min(len(sections)-1, m.current+1)
+		}
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		if m.docked() {
+			// Close the overlay once the sidebar is docked, rather than
+			// have it reappear if the terminal is narrowed again.
+			m.open = false
+		}
+	}
+	return m, nil
+}
+
+func (m model) sidebar(height int) string {
+	var b strings.Builder
+	for i, s := range sections {
+		if i == m.current {
+			b.WriteString(activeStyle.Render("> " + s))
+		} else {
+			b.WriteString("  " + s)
+		}
+		if i < len(sections)-1 {
+			b.WriteString("\n")
+		}
+	}
+	return sidebarStyle.Width(sidebarWidth - 2).Height(height - 2).Render(b.String())
+}
+
+func (m model) content(width, height int) string {
+	return contentStyle.Width(width - 2).Height(height - 2).Render(sections[m.current] + "\n\n" + text)
+}
+
+func (m model) View() string {
+	if m.width == 0 {
+		return ""
+	}
+	height := max(3, m.height-1)
+	var body, mode string
+	switch {
+	case m.docked():
+		mode = "docked"
+		body = lipgloss.JoinHorizontal(lipgloss.Top, m.sidebar(height), m.content(m.width-sidebarWidth, height))
+	case m.open:
+		mode = "overlay (open)"
+		body = overlay(m.content(m.width, height), m.sidebar(height))
+	default:
+		mode = "overlay (closed)"
+		body = m.content(m.width, height)
+	}
+	footer := fmt.Sprintf("sidebar: %s • width: %d • j/k: section", mode, m.width)
+	if !m.docked() {
+		footer += " • s: toggle sidebar"
+	}
+	return body + "\n" + footerStyle.Render(footer+" • q: quit")
+}
+
+// overlay draws top over the left-hand side of bottom. The content is
+// unstyled, so its lines can be cut by rune.
+func overlay(bottom, top string) string {
+	lines := strings.Split(bottom, "\n")
+	for i, line := range strings.Split(top, "\n") {
+		if i >= len(lines) {
+			break
+		}
+		under := []rune(lines[i])
+		lines[i] = line + string(under[min(len(under), lipgloss.Width(line)):])
+	}
+	return strings.Join(lines, "\n")
+}
+
+func main() {
+	p := tea.NewProgram(model{}, tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestBreakpoint(t *testing.T) {
+	tm := teatest.NewTestModel(t, model{}, teatest.WithInitialTermSize(100, 20))
+
+	waitForStrings(t, tm, "sidebar: docked • width: 100", "│   Installation")
+
+	tm.Send(tea.WindowSizeMsg{Width: 60, Height: 20})
+
+	waitForStrings(t, tm, "sidebar: overlay (closed) • width: 60")
+
+	tm.Type("s")
+
+	waitForStrings(t, tm, "sidebar: overlay (open)", "│   Installation")
+
+	// Exactly at the breakpoint there is room to dock the sidebar, which
+	// closes the overlay.
+	tm.Send(tea.WindowSizeMsg{Width: breakpoint, Height: 20})
+
+	waitForStrings(t, tm, "sidebar: docked • width: 80")
+
+	tm.Send(tea.WindowSizeMsg{Width: breakpoint - 1, Height: 20})
+
+	waitForStrings(t, tm, "sidebar: overlay (closed) • width: 79")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.open {
+		t.Error("expected the overlay to stay closed after resizing")
+	}
+}
+
+func TestOverlay(t *testing.T) {
+	got := overlay("abcdef\nghijkl\nmnopqr", "XY\nZ!")
+	if want := "XYcdef\nZ!ijkl\nmnopqr"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
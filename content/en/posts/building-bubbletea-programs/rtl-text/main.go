@@ -0,0 +1,107 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"golang.org/x/text/unicode/bidi"
+)
+
+const columnWidth = 30
+
+var (
+	cellStyle   = lipgloss.NewStyle().Width(columnWidth)
+	cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+)
+
+type model struct {
+	items  []string
+	cursor int
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = min(len(m.items)-1, m.cursor+1)
+		}
+	}
+	return m, nil
+}
+
+// visual returns s reordered for display, along with whether its base
+// direction is right-to-left. Most terminals render characters in the order
+// they're written, so right-to-left runs must be reversed before printing.
+func visual(s string) (string, bool) {
+	var p bidi.Paragraph
+	if _, err := p.SetString(s); err != nil {
+		return s, false
+	}
+	order, err := p.Order()
+	if err != nil {
+		return s, false
+	}
+	rtl := !p.IsLeftToRight()
+	runs := make([]string, order.NumRuns())
+	for i := range runs {
+		run := order.Run(i)
+		if run.Direction() == bidi.RightToLeft {
+			runs[i] = bidi.ReverseString(run.String())
+		} else {
+			runs[i] = run.String()
+		}
+	}
+	// Runs are returned in logical order, so in a right-to-left paragraph
+	// they are laid out from the right.
+	if rtl {
+		slices.Reverse(runs)
+	}
+	return strings.Join(runs, ""), rtl
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	for i, item := range m.items {
+		text, rtl := visual(item)
+		style := cellStyle.Align(lipgloss.Left)
+		if rtl {
+			style = cellStyle.Align(lipgloss.Right)
+		}
+		cell := style.Render(text)
+		if i == m.cursor {
+			cell = cursorStyle.Render(cell)
+		}
+		b.WriteString("│" + cell + "│\n")
+	}
+	b.WriteString("\n↑/↓: move • q: quit\n")
+	return b.String()
+}
+
+func main() {
+	m := model{items: []string{
+		"Hello, world",
+		"שלום עולם",
+		"مرحبا بالعالم",
+		"Order 66 — שלום",
+		"ספר Go חדש",
+		"Bubbletea",
+	}}
+	p := tea.NewProgram(m)
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
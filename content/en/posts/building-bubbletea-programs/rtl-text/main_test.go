@@ -0,0 +1,52 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestAlignment(t *testing.T) {
+	m := model{items: []string{"Hello", "שלום"}}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	// The RTL item is reversed into visual order and pushed to the right
+	// edge of the column.
+	waitForMatch(t, tm, `(?s)│Hello\s+│.*│\s+םולש│`)
+
+	tm.Type("q")
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestMixedDirection(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantRTL bool
+	}{
+		{"Order 66 — שלום", "Order 66 — םולש", false},
+		{"ספר Go", "Go רפס", true},
+		{"ספר Go חדש", "שדח Go רפס", true},
+	}
+	for _, tt := range tests {
+		got, rtl := visual(tt.in)
+		if got != tt.want || rtl != tt.wantRTL {
+			t.Errorf("visual(%q) = %q, %v; want %q, %v", tt.in, got, rtl, tt.want, tt.wantRTL)
+		}
+	}
+}
+
+func waitForMatch(t *testing.T, tm *teatest.TestModel, pattern string) {
+	re := regexp.MustCompile(pattern)
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return re.Match(b)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
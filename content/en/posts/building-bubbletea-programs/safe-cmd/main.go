@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var bannerStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("15")).
+	Background(lipgloss.Color("9")).
+	Padding(0, 1)
+
+// cmdErrorMsg reports a command that panicked.
+type cmdErrorMsg struct {
+	err error
+}
+
+// safeCmd wraps a command, recovering from a panic and converting it into a
+// cmdErrorMsg. Unlike a panic in Update or View, which Bubble Tea recovers
+// from, a panic in a command goroutine crashes the program without
+// restoring the terminal (see the terminal-not-reset example).
+func safeCmd(fn tea.Cmd) tea.Cmd {
+	if fn == nil {
+		return nil
+	}
+	return func() (msg tea.Msg) {
+		defer func() {
+			if r := recover(); r != nil {
+				// The panic value needn't be an error: panic("boom") is
+				// just as common.
+				err, ok := r.(error)
+				if !ok {
+					err = fmt.Errorf("%v", r)
+				}
+				msg = cmdErrorMsg{err: fmt.Errorf("command panicked: %w", err)}
+			}
+		}()
+		return fn()
+	}
+}
+
+type resultMsg string
+
+type model struct {
+	err    error
+	result string
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "p":
+			return m, safeCmd(func() tea.Msg {
+				var items []string
+				return resultMsg(items[3])
+			})
+		case "s":
+			return m, safeCmd(func() tea.Msg {
+				panic("something went wrong")
+			})
+		case "r":
+			return m, safeCmd(func() tea.Msg {
+				return resultMsg("command succeeded")
+			})
+		case "esc":
+			m.err = nil
+		}
+	case cmdErrorMsg:
+		m.err = msg.err
+	case resultMsg:
+		m.result = string(msg)
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	var s string
+	if m.err != nil {
+		s += bannerStyle.Render("✗ "+m.err.Error()+" (esc to dismiss)") + "\n\n"
+	}
+	if m.result != "" {
+		s += m.result + "\n\n"
+	}
+	return s + "p: panic with error • s: panic with string • r: run ok • q: quit\n"
+}
+
+func main() {
+	p := tea.NewProgram(model{}, tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestSafeCmd(t *testing.T) {
+	tm := teatest.NewTestModel(t, model{}, teatest.WithInitialTermSize(80, 24))
+
+	tm.Type("p")
+
+	waitForString(t, tm, "✗ command panicked: runtime error: index out of range")
+
+	// The program is still alive: it can dismiss the banner and run
+	// another command.
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+	tm.Type("r")
+
+	waitForString(t, tm, "command succeeded")
+
+	// A panic with a value that isn't an error.
+	tm.Type("s")
+
+	waitForString(t, tm, "✗ command panicked: something went wrong")
+
+	tm.Type("q")
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
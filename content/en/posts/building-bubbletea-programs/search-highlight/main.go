@@ -0,0 +1,208 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	matchStyle        = lipgloss.NewStyle().Background(lipgloss.Color("58"))
+	currentMatchStyle = lipgloss.NewStyle().Reverse(true).Bold(true)
+)
+
+type match struct {
+	line, col int
+}
+
+type model struct {
+	lines     []string
+	viewport  viewport.Model
+	input     textinput.Model
+	searching bool
+	query     string
+	matches   []match
+	current   int
+	ready     bool
+}
+
+func newModel(lines []string) model {
+	input := textinput.New()
+	input.Prompt = "/"
+	return model{lines: lines, input: input}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		if m.searching {
+			switch msg.String() {
+			case "enter":
+				m.searching = false
+				m.input.Blur()
+				m.search(m.input.Value())
+				return m, nil
+			case "esc":
+				m.searching = false
+				m.input.Blur()
+				return m, nil
+			}
+			var cmd tea.Cmd
+			m.input, cmd = m.input.Update(msg)
+			return m, cmd
+		}
+		switch msg.String() {
+		case "q":
+			return m, tea.Quit
+		case "/":
+			m.searching = true
+			m.input.SetValue("")
+			return m, m.input.Focus()
+		case "n":
+			m.jump(1)
+			return m, nil
+		case "N":
+			m.jump(-1)
+			return m, nil
+		}
+	case tea.WindowSizeMsg:
+		if !m.ready {
+			m.viewport = viewport.New(msg.Width, msg.Height-1)
+			m.ready = true
+		} else {
+			m.viewport.Width = msg.Width
+			m.viewport.Height = msg.Height - 1
+		}
+		m.render()
+		m.center()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// search finds every occurrence of query and moves to the first one.
+func (m *model) search(query string) {
+	m.query = query
+	m.matches = nil
+	m.current = 0
+	if query != "" {
+		for i, line := range m.lines {
+			for col := 0; ; {
+				idx := strings.Index(line[col:], query)
+				if idx < 0 {
+					break
+				}
+				m.matches = append(m.matches, match{line: i, col: col + idx})
+				col += idx + len(query)
+			}
+		}
+	}
+	m.render()
+	m.center()
+}
+
+// jump moves delta matches forwards or backwards, wrapping around at either
+// end.
+func (m *model) jump(delta int) {
+	if len(m.matches) == 0 {
+		return
+	}
+	m.current = (m.current + delta + len(m.matches)) % len(m.matches)
+	m.render()
+	m.center()
+}
+
+// center scrolls the viewport so the current match is in the middle.
+func (m *model) center() {
+	if !m.ready || len(m.matches) == 0 {
+		return
+	}
+	m.viewport.SetYOffset(m.matches[m.current].line - m.viewport.Height/2)
+}
+
+// render rebuilds the viewport content with every match highlighted.
+func (m *model) render() {
+	if !m.ready {
+		return
+	}
+	byLine := make(map[int][]int)
+	for i, mt := range m.matches {
+		byLine[mt.line] = append(byLine[mt.line], i)
+	}
+	var b strings.Builder
+	for i, line := range m.lines {
+		col := 0
+		for _, idx := range byLine[i] {
+			mt := m.matches[idx]
+			b.WriteString(line[col:mt.col])
+			style := matchStyle
+			if idx == m.current {
+				style = currentMatchStyle
+			}
+			b.WriteString(style.Render(line[mt.col : mt.col+len(m.query)]))
+			col = mt.col + len(m.query)
+		}
+		b.WriteString(line[col:])
+		if i < len(m.lines)-1 {
+			b.WriteString("\n")
+		}
+	}
+	m.viewport.SetContent(b.String())
+}
+
+func (m model) View() string {
+	if !m.ready {
+		return "loading..."
+	}
+	return m.viewport.View() + "\n" + m.statusView()
+}
+
+func (m model) statusView() string {
+	if m.searching {
+		return m.input.View()
+	}
+	switch {
+	case m.query == "":
+		return "/: search • q: quit"
+	case len(m.matches) == 0:
+		return fmt.Sprintf("no matches for %q • /: search • q: quit", m.query)
+	default:
+		return fmt.Sprintf("match %d of %d • n/N: next/prev • /: search • q: quit", m.current+1, len(m.matches))
+	}
+}
+
+func document() []string {
+	lines := make([]string, 200)
+	for i := range lines {
+		text := "the quick brown fox jumps over the lazy dog"
+		switch i {
+		case 20, 80, 150:
+			text = "somewhere in this haystack there is a needle"
+		}
+		lines[i] = fmt.Sprintf("%03d %s", i+1, text)
+	}
+	return lines
+}
+
+func main() {
+	p := tea.NewProgram(newModel(document()), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
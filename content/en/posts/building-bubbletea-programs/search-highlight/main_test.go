@@ -0,0 +1,77 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/muesli/termenv"
+)
+
+func TestSearch(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI)
+	t.Cleanup(func() { lipgloss.SetColorProfile(profile) })
+
+	m := newModel(document())
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 20))
+
+	tm.Type("/needle")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForStrings(t, tm, "match 1 of 3", "021 somewhere in this haystack there is a "+currentMatchStyle.Render("needle"))
+
+	tm.Type("n")
+
+	waitForStrings(t, tm, "match 2 of 3", "081 somewhere in this haystack there is a "+currentMatchStyle.Render("needle"))
+
+	// Wrap from the last match to the first.
+	tm.Type("n")
+	waitForStrings(t, tm, "match 3 of 3")
+	tm.Type("n")
+	waitForStrings(t, tm, "match 1 of 3")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestNoMatches(t *testing.T) {
+	m := newModel(document())
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 20))
+
+	tm.Type("/zebra")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForStrings(t, tm, `no matches for "zebra"`)
+
+	tm.Type("n")
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.viewport.YOffset != 0 {
+		t.Errorf("expected viewport to stay at top, got offset %d", fm.viewport.YOffset)
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
+)
+
+// joinedBorder is the border of every segment but the first, whose left
+// edge joins it to the segment before.
+var joinedBorder = func() lipgloss.Border {
+	b := lipgloss.RoundedBorder()
+	b.TopLeft = "┬"
+	b.BottomLeft = "┴"
+	return b
+}()
+
+var (
+	segmentStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			Padding(0, 2)
+	activeStyle = segmentStyle.Reverse(true)
+)
+
+type model struct {
+	segments []string
+	active   int
+	width    int
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "left", "h":
+			// Stop at the first and last segments rather than wrapping
+			// around, as a segmented control isn't circular.
+			m.active = max(0, m.active-1)
+		case "right", "l":
+			m.active = min(len(m.segments)-1, m.active+1)
+		default:
+			if len(msg.Runes) == 1 && msg.Runes[0] >= '1' && msg.Runes[0] <= '9' {
+				if i := int(msg.Runes[0] - '1'); i < len(m.segments) {
+					m.active = i
+				}
+			}
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	cells := make([]string, len(m.segments))
+	for i, segment := range m.segments {
+		style := segmentStyle
+		if i == m.active {
+			style = activeStyle
+		}
+		if i > 0 {
+			style = style.BorderStyle(joinedBorder)
+		}
+		if i < len(m.segments)-1 {
+			// The next segment draws the edge between the two.
+			style = style.BorderRight(false)
+		}
+		cells[i] = style.Render(segment)
+	}
+	control := lipgloss.JoinHorizontal(lipgloss.Top, cells...)
+	if m.width > 0 && lipgloss.Width(control) > m.width {
+		lines := strings.Split(control, "\n")
+		for i, line := range lines {
+			lines[i] = truncate.StringWithTail(line, uint(m.width), "…")
+		}
+		control = strings.Join(lines, "\n")
+	}
+	return fmt.Sprintf("%s\n\nShowing %s.\n\n←/→ or 1-%d: select • q: quit\n", control, m.segments[m.active], len(m.segments))
+}
+
+func main() {
+	p := tea.NewProgram(model{segments: []string{"Day", "Week", "Month", "Year"}})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
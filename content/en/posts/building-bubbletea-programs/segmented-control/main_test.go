@@ -0,0 +1,81 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/muesli/termenv"
+)
+
+func TestSelect(t *testing.T) {
+	// Without a terminal, lipgloss renders no styles at all, so force it
+	// to render the inverted segment.
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI)
+	t.Cleanup(func() { lipgloss.SetColorProfile(profile) })
+
+	m := model{segments: []string{"Day", "Week", "Month", "Year"}}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "Showing Day.")
+
+	tm.Type("3")
+
+	waitForString(t, tm, "Showing Month.")
+
+	// Moving right from the last segment stays on it.
+	tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+	tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+	tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+
+	waitForString(t, tm, "Showing Year.")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	if fm.active != 3 {
+		t.Errorf("expected last segment to be active, got %d", fm.active)
+	}
+	// Only the active segment is rendered inverted.
+	view := fm.View()
+	for _, segment := range fm.segments {
+		inverted := strings.Contains(view, "\x1b[7m"+segment+"\x1b[0m")
+		if want := segment == "Year"; inverted != want {
+			t.Errorf("expected %s inverted to be %v, got %v", segment, want, inverted)
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	const width = 20
+	m := model{segments: []string{"Day", "Week", "Month", "Year"}}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(width, 24))
+
+	waitForString(t, tm, "Showing Day.")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	lines := strings.Split(fm.View(), "\n")
+	for _, line := range lines[:3] {
+		if w := lipgloss.Width(line); w > width {
+			t.Errorf("expected line to fit in %d columns, got %d: %q", width, w, line)
+		}
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
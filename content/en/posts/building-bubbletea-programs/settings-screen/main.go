@@ -0,0 +1,310 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const sliderWidth = 12
+
+var (
+	headerStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("62"))
+	cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	valueStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	errorStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	helpStyle   = lipgloss.NewStyle().Faint(true)
+)
+
+type kind int
+
+const (
+	boolean kind = iota
+	choice
+	number
+)
+
+// setting describes a setting and its default. Options are only used by
+// choices, and min, max and step by numbers.
+type setting struct {
+	key, label     string
+	kind           kind
+	def            any
+	options        []string
+	min, max, step int
+}
+
+type group struct {
+	name     string
+	settings []setting
+}
+
+var groups = []group{
+	{name: "General", settings: []setting{
+		{key: "confirmQuit", label: "Confirm before quitting", kind: boolean, def: true},
+		{key: "theme", label: "Theme", kind: choice, def: "dark", options: []string{"dark", "light", "auto"}},
+	}},
+	{name: "Editor", settings: []setting{
+		{key: "tabWidth", label: "Tab width", kind: number, def: 4, min: 1, max: 8, step: 1},
+		{key: "lineNumbers", label: "Show line numbers", kind: boolean, def: true},
+		{key: "wrap", label: "Wrap lines", kind: choice, def: "word", options: []string{"off", "word", "char"}},
+	}},
+	{name: "Files", settings: []setting{
+		{key: "showHidden", label: "Show hidden files", kind: boolean, def: false},
+		{key: "autosave", label: "Autosave (seconds)", kind: number, def: 10, min: 0, max: 60, step: 5},
+	}},
+}
+
+// values holds the value of every setting, keyed by setting.
+type values map[string]any
+
+func defaults() values {
+	v := make(values)
+	for _, g := range groups {
+		for _, s := range g.settings {
+			v[s.key] = s.def
+		}
+	}
+	return v
+}
+
+// load reads the settings saved to the file, starting from the defaults. A
+// missing file leaves everything at its default, and settings it doesn't
+// know, or with values of the wrong type, are ignored.
+func load(path string) (values, error) {
+	v := defaults()
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return v, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	for _, g := range groups {
+		for _, s := range g.settings {
+			msg, ok := raw[s.key]
+			if !ok {
+				continue
+			}
+			switch s.kind {
+			case boolean:
+				var b bool
+				if json.Unmarshal(msg, &b) == nil {
+					v[s.key] = b
+				}
+			case choice:
+				var str string
+				if json.Unmarshal(msg, &str) == nil && slices.Contains(s.options, str) {
+					v[s.key] = str
+				}
+			case number:
+				var n int
+				if json.Unmarshal(msg, &n) == nil {
+					v[s.key] = max(s.min, min(s.max, n))
+				}
+			}
+		}
+	}
+	return v, nil
+}
+
+type savedMsg struct{ err error }
+
+// saver writes the settings to the file. Each change is saved by its own
+// command, and commands run concurrently, so writes are made one at a time,
+// and one older than the last written is skipped rather than allowed to
+// overwrite it.
+type saver struct {
+	mu      sync.Mutex
+	path    string
+	written int
+}
+
+func (s *saver) write(seq int, b []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if seq <= s.written {
+		return nil
+	}
+	if err := os.WriteFile(s.path, b, 0o644); err != nil {
+		return err
+	}
+	s.written = seq
+	return nil
+}
+
+// save returns a command that saves a snapshot of the values as they are
+// now.
+func (m *model) save() tea.Cmd {
+	m.seq++
+	seq, saver := m.seq, m.saver
+	b, err := json.MarshalIndent(m.values, "", "  ")
+	return func() tea.Msg {
+		if err != nil {
+			return savedMsg{err: err}
+		}
+		return savedMsg{err: saver.write(seq, append(b, '\n'))}
+	}
+}
+
+type model struct {
+	path  string
+	saver *saver
+	// seq numbers each save, so that the saver can tell which is the latest.
+	seq    int
+	values values
+	// cursor is the index of the selected setting, counting across all
+	// the groups.
+	cursor int
+	status string
+	err    error
+}
+
+func newModel(path string, v values) model {
+	return model{path: path, saver: &saver{path: path}, values: v}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+// selected returns the group and setting under the cursor.
+func (m model) selected() (group, setting) {
+	i := m.cursor
+	for _, g := range groups {
+		if i < len(g.settings) {
+			return g, g.settings[i]
+		}
+		i -= len(g.settings)
+	}
+	panic("cursor out of range")
+}
+
+func count() int {
+	n := 0
+	for _, g := range groups {
+		n += len(g.settings)
+	}
+	return n
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = min(count()-1, m.cursor+1)
+		case "right", "l", "enter", " ":
+			return m, m.change(1)
+		case "left", "h":
+			return m, m.change(-1)
+		case "r":
+			g, _ := m.selected()
+			for _, s := range g.settings {
+				m.values[s.key] = s.def
+			}
+			m.status = fmt.Sprintf("reset %s to defaults", g.name)
+			return m, m.save()
+		}
+	case savedMsg:
+		m.err = msg.err
+		if m.err == nil && m.status == "" {
+			m.status = "saved to " + m.path
+		}
+	}
+	return m, nil
+}
+
+// change edits the selected setting in place: toggling a boolean, cycling
+// through the options of a choice, or stepping a number up or down within
+// its limits.
+func (m *model) change(delta int) tea.Cmd {
+	_, s := m.selected()
+	before := m.values[s.key]
+	switch s.kind {
+	case boolean:
+		m.values[s.key] = !m.values[s.key].(bool)
+	case choice:
+		i := slices.Index(s.options, m.values[s.key].(string))
+		m.values[s.key] = s.options[(i+delta+len(s.options))%len(s.options)]
+	case number:
+		m.values[s.key] = max(s.min, min(s.max, m.values[s.key].(int)+delta*s.step))
+	}
+	if m.values[s.key] == before {
+		return nil
+	}
+	m.status = ""
+	return m.save()
+}
+
+func (m model) value(s setting) string {
+	switch s.kind {
+	case boolean:
+		if m.values[s.key].(bool) {
+			return "[x]"
+		}
+		return "[ ]"
+	case choice:
+		return "‹ " + m.values[s.key].(string) + " ›"
+	case number:
+		n := m.values[s.key].(int)
+		filled := (n - s.min) * sliderWidth / max(1, s.max-s.min)
+		return strings.Repeat("█", filled) + strings.Repeat("░", sliderWidth-filled) + fmt.Sprintf(" %d", n)
+	}
+	return ""
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	i := 0
+	for _, g := range groups {
+		b.WriteString(headerStyle.Render(g.name) + "\n")
+		for _, s := range g.settings {
+			line := fmt.Sprintf("%-24s %s", s.label, valueStyle.Render(m.value(s)))
+			if i == m.cursor {
+				b.WriteString(cursorStyle.Render("> ") + line + "\n")
+			} else {
+				b.WriteString("  " + line + "\n")
+			}
+			i++
+		}
+		b.WriteString("\n")
+	}
+	if m.err != nil {
+		b.WriteString(errorStyle.Render("could not save settings: "+m.err.Error()) + "\n")
+	} else if m.status != "" {
+		b.WriteString(m.status + "\n")
+	}
+	b.WriteString(helpStyle.Render("↑/↓: move • ←/→/space: change • r: reset group • q: quit") + "\n")
+	return b.String()
+}
+
+func main() {
+	path := filepath.Join(os.TempDir(), "settings-screen.json")
+	v, err := load(path)
+	if err != nil {
+		fmt.Println("could not load settings:", err)
+		os.Exit(1)
+	}
+	p := tea.NewProgram(newModel(path, v))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
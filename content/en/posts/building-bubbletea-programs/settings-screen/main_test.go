@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestPersist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings.json")
+	v, err := load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tm := teatest.NewTestModel(t, newModel(path, v), teatest.WithInitialTermSize(100, 24))
+
+	waitForString(t, tm, "Show hidden files        [ ]")
+
+	// Down to the first setting in the Files group.
+	for range 5 {
+		tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	tm.Send(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+
+	waitForString(t, tm, "saved to "+path)
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+
+	// A fresh model picks up the saved setting.
+	reloaded, err := load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fresh := newModel(path, reloaded)
+	if got := fresh.values["showHidden"]; got != true {
+		t.Errorf("expected showHidden to be saved, got %v", got)
+	}
+	if view := fresh.View(); !strings.Contains(view, "Show hidden files        [x]") {
+		t.Errorf("expected setting to be shown as on:\n%s", view)
+	}
+}
+
+func TestLoadIgnoresUnknown(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings.json")
+	content := `{"theme": "light", "fontSize": 14, "tabWidth": "wide", "autosave": 100}`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	v, err := load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := defaults()
+	want["theme"] = "light"
+	// Clamped to the maximum.
+	want["autosave"] = 60
+	if fmt.Sprint(v) != fmt.Sprint(want) {
+		t.Errorf("want %v, got %v", want, v)
+	}
+}
+
+func TestResetGroup(t *testing.T) {
+	v := defaults()
+	v["theme"] = "light"
+	v["tabWidth"] = 8
+	v["wrap"] = "off"
+	m := newModel(filepath.Join(t.TempDir(), "settings.json"), v)
+	// Tab width, in the Editor group.
+	m.cursor = 2
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	m = updated.(model)
+
+	if m.values["tabWidth"] != 4 || m.values["wrap"] != "word" {
+		t.Errorf("expected Editor settings to be reset, got %v", m.values)
+	}
+	if m.values["theme"] != "light" {
+		t.Errorf("expected other groups to be left alone, got theme %v", m.values["theme"])
+	}
+	if m.status != "reset Editor to defaults" {
+		t.Errorf("unexpected status %q", m.status)
+	}
+}
+
+func TestSavesOutOfOrder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings.json")
+	m := newModel(path, defaults())
+
+	// Toggle confirming before quitting off, and back on again.
+	first := m.change(1)
+	second := m.change(1)
+
+	// The second save finishes before the first, which mustn't overwrite
+	// it.
+	second()
+	first()
+
+	saved, err := load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !maps.Equal(saved, m.values) {
+		t.Errorf("expected the latest values to be saved, want %v, got %v", m.values, saved)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
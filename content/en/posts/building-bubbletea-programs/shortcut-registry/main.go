@@ -0,0 +1,224 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	overlayStyle  = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+	keyStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	disabledStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	cursorStyle   = lipgloss.NewStyle().Reverse(true)
+)
+
+// Action is something the user can do. The list of actions is the single
+// source of truth for dispatching keys, the help overlay and the command
+// palette.
+type Action struct {
+	name string
+	// keys are the keys that run the action, the first of which is shown
+	// in help. An action without keys can only be run from the palette.
+	keys []string
+	// enabled reports whether the action can currently be run. It's
+	// always enabled if nil.
+	enabled func(model) bool
+	run     func(*model) tea.Cmd
+}
+
+func (a Action) isEnabled(m model) bool {
+	return a.enabled == nil || a.enabled(m)
+}
+
+func (a Action) help() string {
+	if len(a.keys) == 0 {
+		return ""
+	}
+	return a.keys[0]
+}
+
+func nonZero(m model) bool { return m.count != 0 }
+
+var actions = []Action{
+	{name: "increment", keys: []string{"+", "up"}, run: func(m *model) tea.Cmd {
+		m.count++
+		return nil
+	}},
+	{name: "decrement", keys: []string{"-", "down"}, enabled: nonZero, run: func(m *model) tea.Cmd {
+		m.count--
+		return nil
+	}},
+	{name: "double", keys: []string{"d"}, enabled: nonZero, run: func(m *model) tea.Cmd {
+		m.count *= 2
+		return nil
+	}},
+	{name: "reset", keys: []string{"r"}, enabled: nonZero, run: func(m *model) tea.Cmd {
+		m.count = 0
+		return nil
+	}},
+	{name: "add ten", run: func(m *model) tea.Cmd {
+		m.count += 10
+		return nil
+	}},
+	{name: "toggle help", keys: []string{"?"}, run: func(m *model) tea.Cmd {
+		m.showHelp = !m.showHelp
+		return nil
+	}},
+	{name: "command palette", keys: []string{"ctrl+p"}, run: func(m *model) tea.Cmd {
+		m.palette = true
+		m.paletteCursor = 0
+		m.input.SetValue("")
+		return m.input.Focus()
+	}},
+	{name: "quit", keys: []string{"q", "ctrl+c"}, run: func(*model) tea.Cmd {
+		return tea.Quit
+	}},
+}
+
+type model struct {
+	count  int
+	status string
+
+	showHelp bool
+
+	palette       bool
+	input         textinput.Model
+	paletteCursor int
+}
+
+func newModel() model {
+	input := textinput.New()
+	input.Prompt = "> "
+	input.Placeholder = "type to search actions"
+	return model{input: input}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.palette {
+			return m.updatePalette(msg)
+		}
+		m.status = ""
+		for _, a := range actions {
+			if slices.Contains(a.keys, msg.String()) {
+				return m.runAction(a)
+			}
+		}
+	}
+	return m, nil
+}
+
+func (m model) runAction(a Action) (tea.Model, tea.Cmd) {
+	if !a.isEnabled(m) {
+		m.status = a.name + " is disabled"
+		return m, nil
+	}
+	cmd := a.run(&m)
+	return m, cmd
+}
+
+// matches returns the actions whose name contains the palette's query.
+func (m model) matches() []Action {
+	query := strings.ToLower(m.input.Value())
+	var matches []Action
+	for _, a := range actions {
+		if strings.Contains(a.name, query) {
+			matches = append(matches, a)
+		}
+	}
+	return matches
+}
+
+func (m model) updatePalette(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		m.palette = false
+		m.input.Blur()
+		return m, nil
+	case "up":
+		m.paletteCursor = max(0, m.paletteCursor-1)
+		return m, nil
+	case "down":
+		m.paletteCursor = max(0, min(len(m.matches())-1, m.paletteCursor+1))
+		return m, nil
+	case "enter":
+		matches := m.matches()
+		if len(matches) == 0 {
+			return m, nil
+		}
+		m.palette = false
+		m.input.Blur()
+		m.status = ""
+		return m.runAction(matches[m.paletteCursor])
+	}
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	// The matches may have changed, so start again at the top.
+	m.paletteCursor = 0
+	return m, cmd
+}
+
+// actionView renders an action for the help overlay and palette, greying
+// it out if it's disabled.
+func (m model) actionView(a Action) string {
+	line := fmt.Sprintf("%-8s %s", a.help(), a.name)
+	if !a.isEnabled(m) {
+		return disabledStyle.Render(line + " (disabled)")
+	}
+	return keyStyle.Render(fmt.Sprintf("%-8s", a.help())) + " " + a.name
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "count: %d\n\n", m.count)
+	switch {
+	case m.palette:
+		lines := []string{m.input.View(), ""}
+		matches := m.matches()
+		for i, a := range matches {
+			line := m.actionView(a)
+			if i == m.paletteCursor {
+				line = cursorStyle.Render(">") + " " + line
+			} else {
+				line = "  " + line
+			}
+			lines = append(lines, line)
+		}
+		if len(matches) == 0 {
+			lines = append(lines, disabledStyle.Render("no matching actions"))
+		}
+		b.WriteString(overlayStyle.Render(strings.Join(lines, "\n")) + "\n")
+	case m.showHelp:
+		lines := make([]string, len(actions))
+		for i, a := range actions {
+			lines[i] = m.actionView(a)
+		}
+		b.WriteString(overlayStyle.Render(strings.Join(lines, "\n")) + "\n")
+	}
+	if m.status != "" {
+		b.WriteString(m.status + "\n")
+	}
+	b.WriteString(disabledStyle.Render("?: help • ctrl+p: command palette") + "\n")
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
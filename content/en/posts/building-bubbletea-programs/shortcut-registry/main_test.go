@@ -0,0 +1,99 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestHelpAndBinding(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(), teatest.WithInitialTermSize(80, 24))
+
+	tm.Type("?")
+
+	// Reset is disabled while the count is zero.
+	waitForStrings(t, tm, "+        increment", "r        reset (disabled)")
+
+	tm.Type("r")
+
+	waitForString(t, tm, "reset is disabled")
+
+	tm.Type("+++")
+
+	waitForStrings(t, tm, "count: 3", "r        reset           │")
+
+	tm.Type("r")
+
+	waitForString(t, tm, "count: 0")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestPalette(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(), teatest.WithInitialTermSize(80, 24))
+
+	// Add ten has no binding, so can only be run from the palette.
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlP})
+	tm.Type("ten")
+
+	waitForString(t, tm, ">          add ten")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "count: 10")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlP})
+	tm.Type("dou")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "count: 20")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestPaletteDisabled(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(), teatest.WithInitialTermSize(80, 24))
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlP})
+	tm.Type("reset")
+
+	waitForString(t, tm, "reset (disabled)")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "reset is disabled")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	waitForStrings(t, tm, s)
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
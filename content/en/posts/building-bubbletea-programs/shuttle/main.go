@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	cursorStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	listStyle    = lipgloss.NewStyle().Width(20).Padding(0, 1).Border(lipgloss.RoundedBorder())
+	focusedStyle = listStyle.BorderForeground(lipgloss.Color("205"))
+)
+
+const (
+	available = iota
+	selected
+)
+
+type model struct {
+	lists   [2][]string
+	cursors [2]int
+	focus   int
+}
+
+func newModel(items []string) model {
+	items = slices.Clone(items)
+	slices.Sort(items)
+	return model{lists: [2][]string{items, nil}}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "tab":
+			m.focus = 1 - m.focus
+		case "up", "k":
+			m.cursors[m.focus] = max(0, m.cursors[m.focus]-1)
+		case "down", "j":
+			m.cursors[m.focus] = max(0, min(len(m.lists[m.focus])-1, m.cursors[m.focus]+1))
+		case "enter":
+			m.move(m.focus)
+		case "right", "l":
+			if m.focus == available {
+				m.move(available)
+			}
+		case "left", "h":
+			if m.focus == selected {
+				m.move(selected)
+			}
+		}
+	}
+	return m, nil
+}
+
+// move moves the item under the cursor in the given list to the other list.
+func (m *model) move(from int) {
+	to := 1 - from
+	src := m.lists[from]
+	if len(src) == 0 {
+		return
+	}
+	i := m.cursors[from]
+	item := src[i]
+	m.lists[from] = slices.Delete(slices.Clone(src), i, i+1)
+
+	// Insert in order, so the list stays sorted.
+	dst := slices.Clone(m.lists[to])
+	j, _ := slices.BinarySearch(dst, item)
+	m.lists[to] = slices.Insert(dst, j, item)
+
+	// The cursor stays on the same row, unless the last item was moved, in
+	// which case it moves up to the new last item.
+	m.cursors[from] = max(0, min(i, len(m.lists[from])-1))
+}
+
+func (m model) View() string {
+	titles := [2]string{"Available", "Selected"}
+	var columns []string
+	for i, list := range m.lists {
+		var b strings.Builder
+		fmt.Fprintf(&b, "%s (%d)\n\n", titles[i], len(list))
+		if len(list) == 0 {
+			b.WriteString("(empty)")
+		}
+		for j, item := range list {
+			if i == m.focus && j == m.cursors[i] {
+				b.WriteString(cursorStyle.Render("> " + item))
+			} else {
+				b.WriteString("  " + item)
+			}
+			if j < len(list)-1 {
+				b.WriteString("\n")
+			}
+		}
+		style := listStyle
+		if i == m.focus {
+			style = focusedStyle
+		}
+		columns = append(columns, style.Render(b.String()))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, columns...) + "\n\nenter/→/←: move • tab: switch list • q: quit\n"
+}
+
+func main() {
+	p := tea.NewProgram(newModel([]string{"reading", "cycling", "chess", "baking", "hiking", "painting"}))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
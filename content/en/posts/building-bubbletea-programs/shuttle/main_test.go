@@ -0,0 +1,55 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestMove(t *testing.T) {
+	m := newModel([]string{"charlie", "alpha", "bravo"})
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "Available (3)")
+
+	// Moving from the empty selected list does nothing.
+	tm.Send(tea.KeyMsg{Type: tea.KeyTab})
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+	tm.Send(tea.KeyMsg{Type: tea.KeyTab})
+
+	// Move bravo, then charlie, which the cursor lands on after the move.
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+	tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+
+	waitForString(t, tm, "Available (1)")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	if want := []string{"alpha"}; !slices.Equal(fm.lists[available], want) {
+		t.Errorf("expected available to be %q, got %q", want, fm.lists[available])
+	}
+	if want := []string{"bravo", "charlie"}; !slices.Equal(fm.lists[selected], want) {
+		t.Errorf("expected selected to be %q, got %q", want, fm.lists[selected])
+	}
+	if fm.cursors[available] != 0 {
+		t.Errorf("expected cursor to move to the last item, got %d", fm.cursors[available])
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
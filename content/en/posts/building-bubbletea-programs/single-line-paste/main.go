@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var helpStyle = lipgloss.NewStyle().Faint(true)
+
+// pasteMode determines how a multi-line paste is made to fit on one line.
+type pasteMode int
+
+const (
+	// joinLines joins the lines with a space.
+	joinLines pasteMode = iota
+	// firstLine keeps only the first line.
+	firstLine
+)
+
+func (p pasteMode) String() string {
+	if p == firstLine {
+		return "first line"
+	}
+	return "join lines"
+}
+
+// singleLine makes pasted text fit on one line. Each line is trimmed of
+// surrounding whitespace and blank lines are skipped, so that indentation
+// and trailing newlines don't turn into runs of spaces.
+func singleLine(s string, mode pasteMode) string {
+	var lines []string
+	for _, line := range strings.FieldsFunc(s, func(r rune) bool { return r == '\n' || r == '\r' }) {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	if mode == firstLine {
+		return lines[0]
+	}
+	return strings.Join(lines, " ")
+}
+
+type model struct {
+	input  textinput.Model
+	mode   pasteMode
+	status string
+}
+
+func newModel(mode pasteMode) model {
+	input := textinput.New()
+	input.Placeholder = "paste something with several lines"
+	input.Width = 60
+	input.Focus()
+	return model{input: input, mode: mode}
+}
+
+func (m model) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		m.status = ""
+		switch msg.String() {
+		case "ctrl+c", "esc":
+			return m, tea.Quit
+		case "ctrl+t":
+			m.mode = 1 - m.mode
+			return m, nil
+		}
+		if msg.Paste {
+			// The text input would otherwise replace each newline with a
+			// space, keeping any indentation.
+			sanitized := singleLine(string(msg.Runes), m.mode)
+			if sanitized == "" {
+				m.status = "nothing to paste"
+				return m, nil
+			}
+			msg.Runes = []rune(sanitized)
+		}
+		var cmd tea.Cmd
+		m.input, cmd = m.input.Update(msg)
+		return m, cmd
+	}
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	b.WriteString(m.input.View() + "\n\n")
+	if m.status != "" {
+		b.WriteString(m.status + "\n")
+	}
+	b.WriteString(helpStyle.Render(fmt.Sprintf("paste mode: %s • ctrl+t: change mode • esc: quit", m.mode)) + "\n")
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel(joinLines))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
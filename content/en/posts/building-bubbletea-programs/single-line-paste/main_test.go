@@ -0,0 +1,94 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func paste(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s), Paste: true}
+}
+
+func TestPaste(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(joinLines), teatest.WithInitialTermSize(80, 20))
+
+	tm.Type("note: ")
+	tm.Send(paste("  first line\r\n    second line\n\n\tthird line  \n"))
+
+	waitForString(t, tm, "note: first line second line third line")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.input.Value(); got != "note: first line second line third line" {
+		t.Errorf("unexpected value: %q", got)
+	}
+}
+
+func TestPasteFirstLine(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(firstLine), teatest.WithInitialTermSize(80, 20))
+
+	tm.Send(paste("\n\n   only this\nnot this\n"))
+
+	waitForString(t, tm, "only this")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.input.Value(); got != "only this" {
+		t.Errorf("unexpected value: %q", got)
+	}
+}
+
+func TestPasteEmpty(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(joinLines), teatest.WithInitialTermSize(80, 20))
+
+	tm.Type("abc")
+	tm.Send(paste(" \n\t\n "))
+
+	waitForString(t, tm, "nothing to paste")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.input.Value(); got != "abc" {
+		t.Errorf("unexpected value: %q", got)
+	}
+}
+
+func TestSingleLine(t *testing.T) {
+	tests := []struct {
+		in   string
+		mode pasteMode
+		want string
+	}{
+		{"one line", joinLines, "one line"},
+		{"  padded  ", joinLines, "padded"},
+		{"a\nb\r\nc", joinLines, "a b c"},
+		{"a\nb\r\nc", firstLine, "a"},
+		{"\n\n  a  \n", firstLine, "a"},
+		{"", joinLines, ""},
+		{"\r\n \n", firstLine, ""},
+	}
+	for _, tt := range tests {
+		if got := singleLine(tt.in, tt.mode); got != tt.want {
+			t.Errorf("singleLine(%q, %s): want %q, got %q", tt.in, tt.mode, tt.want, got)
+		}
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var boxStyle = lipgloss.NewStyle().
+	Border(lipgloss.RoundedBorder()).
+	Padding(0, 1)
+
+type model struct {
+	width, height int
+	// ready is set on the first tea.WindowSizeMsg. Until then the width and
+	// height are zero, and View can't lay anything out.
+	ready bool
+	// pending holds keys pressed before the program is ready, to be
+	// replayed once it is.
+	pending []tea.KeyMsg
+	typed   string
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			return m, tea.Quit
+		}
+		if !m.ready {
+			m.pending = append(m.pending, msg)
+			return m, nil
+		}
+		switch msg.Type {
+		case tea.KeyRunes, tea.KeySpace:
+			m.typed += msg.String()
+		case tea.KeyBackspace:
+			if r := []rune(m.typed); len(r) > 0 {
+				m.typed = string(r[:len(r)-1])
+			}
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		if !m.ready {
+			m.ready = true
+			var cmds []tea.Cmd
+			for _, key := range m.pending {
+				updated, cmd := m.Update(key)
+				m = updated.(model)
+				cmds = append(cmds, cmd)
+			}
+			m.pending = nil
+			return m, tea.Batch(cmds...)
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if !m.ready {
+		return "loading..."
+	}
+	box := boxStyle.
+		Width(m.width / 2).
+		Render("typed: " + m.typed)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
+
+func main() {
+	p := tea.NewProgram(model{}, tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
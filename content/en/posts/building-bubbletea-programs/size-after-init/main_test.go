@@ -0,0 +1,45 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestSizeAfterInit(t *testing.T) {
+	// No initial size, so no tea.WindowSizeMsg is sent until the test
+	// sends one.
+	tm := teatest.NewTestModel(t, model{})
+
+	waitForString(t, tm, "loading...")
+
+	// Keys pressed before the size is known are held back...
+	tm.Type("hi")
+
+	tm.Send(tea.WindowSizeMsg{Width: 40, Height: 10})
+
+	// ...and replayed once it is.
+	waitForString(t, tm, "typed: hi")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	fm := tm.FinalModel(t).(model)
+	if len(fm.pending) != 0 {
+		t.Errorf("expected no pending keys, got %d", len(fm.pending))
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// shimmer is the palette the highlight sweeps through, from the base colour
+// of a placeholder block to its brightest.
+var shimmer = []lipgloss.Color{"236", "237", "238", "239", "240", "241"}
+
+var (
+	titleStyle = lipgloss.NewStyle().Bold(true).MarginBottom(1)
+	errorStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("9")).
+			Padding(0, 1)
+)
+
+type item struct {
+	name, description string
+}
+
+type (
+	loadedMsg struct{ items []item }
+	errMsg    struct{ err error }
+	tickMsg   struct{}
+)
+
+type model struct {
+	load    func() ([]item, error)
+	loading bool
+	frame   int
+	items   []item
+	err     error
+}
+
+func newModel(load func() ([]item, error)) model {
+	return model{load: load, loading: true}
+}
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(tick(), m.fetch)
+}
+
+func (m model) fetch() tea.Msg {
+	items, err := m.load()
+	if err != nil {
+		return errMsg{err}
+	}
+	return loadedMsg{items}
+}
+
+func tick() tea.Cmd {
+	return tea.Tick(80*time.Millisecond, func(time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case tickMsg:
+		if !m.loading {
+			return m, nil
+		}
+		m.frame++
+		return m, tick()
+	case loadedMsg:
+		m.loading = false
+		m.items = msg.items
+	case errMsg:
+		m.loading = false
+		m.err = msg.err
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	switch {
+	case m.loading:
+		return m.skeletonView()
+	case m.err != nil:
+		return errorStyle.Render("Failed to load items:\n" + m.err.Error())
+	}
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Items"))
+	b.WriteString("\n")
+	for _, it := range m.items {
+		fmt.Fprintf(&b, "%s\n  %s\n", it.name, it.description)
+	}
+	return b.String()
+}
+
+// skeletonView renders placeholder blocks in the same shape as the loaded
+// view, with a highlight that sweeps across them on each frame.
+func (m model) skeletonView() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(m.block(0, 5)))
+	b.WriteString("\n")
+	for i := range 3 {
+		fmt.Fprintf(&b, "%s\n  %s\n", m.block(i*2+1, 12), m.block(i*2+2, 30))
+	}
+	return b.String()
+}
+
+// block renders a placeholder of the given width. The row offsets the sweep
+// so that it travels diagonally down the screen.
+func (m model) block(row, width int) string {
+	const period = 40
+	pos := (m.frame*2 - row) % period
+	var b strings.Builder
+	for x := range width {
+		dist := abs(x - pos)
+		shade := max(0, len(shimmer)-1-dist)
+		b.WriteString(lipgloss.NewStyle().Foreground(shimmer[shade]).Render("█"))
+	}
+	return b.String()
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func load() ([]item, error) {
+	time.Sleep(2 * time.Second)
+	if rand.Intn(5) == 0 {
+		return nil, errors.New("connection reset by peer")
+	}
+	return []item{
+		{"Ramen", "A japanese noodle soup"},
+		{"Tacos", "Tortillas with a filling"},
+		{"Pho", "A vietnamese noodle soup"},
+	}, nil
+}
+
+func main() {
+	p := tea.NewProgram(newModel(load))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
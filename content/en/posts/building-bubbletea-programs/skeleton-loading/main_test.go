@@ -0,0 +1,54 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestSkeleton(t *testing.T) {
+	m := newModel(func() ([]item, error) {
+		time.Sleep(500 * time.Millisecond)
+		return []item{{"Ramen", "A japanese noodle soup"}}, nil
+	})
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "████████████")
+
+	waitForString(t, tm, "A japanese noodle soup")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if strings.Contains(fm.View(), "█") {
+		t.Error("expected skeleton blocks to be replaced by content")
+	}
+}
+
+func TestLoadError(t *testing.T) {
+	m := newModel(func() ([]item, error) {
+		return nil, errors.New("boom")
+	})
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "Failed to load items:")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// pulses is the number of frames a finished task's mark pulses for.
+const pulses = 6
+
+var (
+	spinnerStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	pendingStyle = lipgloss.NewStyle().Faint(true)
+	// The mark alternates between its bright and normal color while it
+	// pulses.
+	successStyles = []lipgloss.Style{
+		lipgloss.NewStyle().Foreground(lipgloss.Color("10")),
+		lipgloss.NewStyle().Foreground(lipgloss.Color("120")).Bold(true),
+	}
+	failureStyles = []lipgloss.Style{
+		lipgloss.NewStyle().Foreground(lipgloss.Color("9")),
+		lipgloss.NewStyle().Foreground(lipgloss.Color("210")).Bold(true),
+	}
+	errorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+)
+
+type state int
+
+const (
+	pending state = iota
+	running
+	done
+	failed
+)
+
+type task struct {
+	name  string
+	run   func() error
+	state state
+	err   error
+	// pulse counts down the frames left to pulse once the task finishes.
+	pulse int
+}
+
+type (
+	finishedMsg struct {
+		idx int
+		err error
+	}
+	pulseMsg struct{}
+)
+
+type model struct {
+	tasks    []task
+	spinner  spinner.Model
+	interval time.Duration
+	// pulsing is true while a pulse tick is scheduled, so that a task
+	// finishing mid-pulse doesn't start a second tick loop.
+	pulsing bool
+}
+
+func newModel(interval time.Duration, tasks ...task) model {
+	if len(tasks) > 0 {
+		// The first task is started by Init.
+		tasks[0].state = running
+	}
+	return model{
+		tasks:    tasks,
+		spinner:  spinner.New(spinner.WithSpinner(spinner.Line), spinner.WithStyle(spinnerStyle)),
+		interval: interval,
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	if len(m.tasks) == 0 {
+		return nil
+	}
+	return m.start(0)
+}
+
+// start runs the task, along with a tick for the spinner. If the spinner
+// is already going, as it is when one task follows another, the spinner
+// ignores the extra tick, so it doesn't speed up.
+func (m model) start(i int) tea.Cmd {
+	run := m.tasks[i].run
+	return tea.Batch(func() tea.Msg {
+		return finishedMsg{idx: i, err: run()}
+	}, m.spinner.Tick)
+}
+
+func (m model) running() bool {
+	for _, t := range m.tasks {
+		if t.state == running {
+			return true
+		}
+	}
+	return false
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case finishedMsg:
+		t := &m.tasks[msg.idx]
+		t.err, t.pulse = msg.err, pulses
+		if msg.err != nil {
+			t.state = failed
+		} else {
+			t.state = done
+		}
+		cmds := []tea.Cmd{m.pulse()}
+		if next := msg.idx + 1; next < len(m.tasks) {
+			m.tasks[next].state = running
+			cmds = append(cmds, m.start(next))
+		}
+		return m, tea.Batch(cmds...)
+	case spinner.TickMsg:
+		// Stop the spinner once there's nothing running, which may be
+		// before its first frame if the task finished straight away.
+		if !m.running() {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+	case pulseMsg:
+		m.pulsing = false
+		pulsing := false
+		for i := range m.tasks {
+			if m.tasks[i].pulse > 0 {
+				m.tasks[i].pulse--
+				pulsing = pulsing || m.tasks[i].pulse > 0
+			}
+		}
+		if pulsing {
+			return m, m.pulse()
+		}
+	}
+	return m, nil
+}
+
+func (m *model) pulse() tea.Cmd {
+	if m.pulsing {
+		return nil
+	}
+	m.pulsing = true
+	return tea.Tick(m.interval, func(time.Time) tea.Msg {
+		return pulseMsg{}
+	})
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	for _, t := range m.tasks {
+		var cell string
+		switch t.state {
+		case pending:
+			cell = pendingStyle.Render("·")
+		case running:
+			cell = m.spinner.View()
+		case done:
+			cell = successStyles[t.pulse%2].Render("✓")
+		case failed:
+			cell = failureStyles[t.pulse%2].Render("✗")
+		}
+		b.WriteString(cell + " " + t.name)
+		if t.err != nil {
+			b.WriteString(" " + errorStyle.Render(t.err.Error()))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\nq: quit\n")
+	return b.String()
+}
+
+func sleep(d time.Duration, err error) func() error {
+	return func() error {
+		time.Sleep(d)
+		return err
+	}
+}
+
+func main() {
+	tasks := []task{
+		{name: "fetch dependencies", run: sleep(1500*time.Millisecond, nil)},
+		{name: "check formatting", run: sleep(0, nil)},
+		{name: "compile", run: sleep(2*time.Second, nil)},
+		{name: "run tests", run: sleep(1200*time.Millisecond, errors.New("2 tests failed"))},
+	}
+	p := tea.NewProgram(newModel(80*time.Millisecond, tasks...))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
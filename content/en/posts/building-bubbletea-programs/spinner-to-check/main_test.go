@@ -0,0 +1,86 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestSpinnerToCheck(t *testing.T) {
+	build, test := make(chan error), make(chan error)
+	m := newModel(10*time.Millisecond,
+		task{name: "build", run: func() error { return <-build }},
+		// Finishes straight away, before the spinner's first frame.
+		task{name: "lint", run: func() error { return nil }},
+		task{name: "test", run: func() error { return <-test }},
+	)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForStrings(t, tm, "| build", "· lint", "· test")
+
+	build <- nil
+
+	waitForStrings(t, tm, "✓ build", "✓ lint")
+
+	test <- errors.New("2 tests failed")
+
+	waitForStrings(t, tm, "✗ test 2 tests failed")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	for i, want := range []state{done, done, failed} {
+		if got := fm.tasks[i].state; got != want {
+			t.Errorf("task %d: want state %d, got %d", i, want, got)
+		}
+	}
+}
+
+func TestPulse(t *testing.T) {
+	m := newModel(time.Hour, task{name: "build"}, task{name: "test"})
+
+	updated, _ := m.Update(finishedMsg{idx: 0})
+	m = updated.(model)
+	if m.tasks[0].pulse != pulses || !m.pulsing {
+		t.Fatalf("expected the check to start pulsing, got %d", m.tasks[0].pulse)
+	}
+	if m.tasks[1].state != running {
+		t.Fatalf("expected the next task to start, got state %d", m.tasks[1].state)
+	}
+
+	// The second task finishes mid-pulse, and pulses alongside the first.
+	updated, _ = m.Update(pulseMsg{})
+	m = updated.(model)
+	updated, _ = m.Update(finishedMsg{idx: 1})
+	m = updated.(model)
+
+	for range pulses {
+		updated, _ = m.Update(pulseMsg{})
+		m = updated.(model)
+	}
+	if m.tasks[0].pulse != 0 || m.tasks[1].pulse != 0 || m.pulsing {
+		t.Errorf("expected pulsing to stop, got %d and %d", m.tasks[0].pulse, m.tasks[1].pulse)
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
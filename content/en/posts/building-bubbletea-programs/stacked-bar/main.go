@@ -0,0 +1,184 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	labelWidth = 10
+	totalWidth = 8
+)
+
+var emptyStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+
+type series struct {
+	name  string
+	color lipgloss.Color
+}
+
+// bar has one value for each series.
+type bar struct {
+	label  string
+	values []float64
+}
+
+func (b bar) total() float64 {
+	var total float64
+	for _, v := range b.values {
+		total += max(0, v)
+	}
+	return total
+}
+
+// segments splits width cells between the values in proportion to their
+// size. The widths always add up to the width, with the cells left over
+// from rounding down going to the values that lost the most. A value that
+// would otherwise round to nothing takes a cell from the widest segment,
+// so that every series in the bar stays visible.
+func segments(values []float64, width int) []int {
+	widths := make([]int, len(values))
+	var total float64
+	for _, v := range values {
+		total += max(0, v)
+	}
+	if total == 0 || width <= 0 {
+		return widths
+	}
+	remainders := make([]float64, len(values))
+	used := 0
+	for i, v := range values {
+		exact := max(0, v) / total * float64(width)
+		widths[i] = int(exact)
+		remainders[i] = exact - float64(widths[i])
+		used += widths[i]
+	}
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		switch {
+		case remainders[a] > remainders[b]:
+			return -1
+		case remainders[a] < remainders[b]:
+			return 1
+		}
+		return 0
+	})
+	for _, i := range order[:width-used] {
+		widths[i]++
+	}
+	for i, v := range values {
+		if v <= 0 || widths[i] > 0 {
+			continue
+		}
+		widest := 0
+		for j := range widths {
+			if widths[j] > widths[widest] {
+				widest = j
+			}
+		}
+		if widths[widest] <= 1 {
+			// There's not enough room to show every series.
+			break
+		}
+		widths[widest]--
+		widths[i]++
+	}
+	return widths
+}
+
+type model struct {
+	series []series
+	bars   []bar
+	width  int
+}
+
+func newModel(series []series, bars []bar) model {
+	return model{series: series, bars: bars, width: 80}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	// Bars are scaled so that the largest total fills the available width.
+	var largest float64
+	for _, b := range m.bars {
+		largest = max(largest, b.total())
+	}
+	available := max(1, m.width-labelWidth-totalWidth-2)
+
+	var b strings.Builder
+	for _, bar := range m.bars {
+		fmt.Fprintf(&b, "%-*s ", labelWidth, bar.label)
+		total := bar.total()
+		if total == 0 {
+			// Draw something, so a zero bar isn't mistaken for a
+			// missing one.
+			b.WriteString(emptyStyle.Render("│"))
+			b.WriteString(strings.Repeat(" ", available-1))
+		} else {
+			width := max(1, int(total/largest*float64(available)+0.5))
+			for i, w := range segments(bar.values, width) {
+				if w == 0 {
+					continue
+				}
+				style := lipgloss.NewStyle().Foreground(m.series[i].color)
+				b.WriteString(style.Render(strings.Repeat("█", w)))
+			}
+			b.WriteString(strings.Repeat(" ", available-width))
+		}
+		fmt.Fprintf(&b, " %*.0f\n", totalWidth, total)
+	}
+	b.WriteString("\n" + m.legend() + "\n\nq: quit\n")
+	return b.String()
+}
+
+func (m model) legend() string {
+	items := make([]string, len(m.series))
+	for i, s := range m.series {
+		items[i] = lipgloss.NewStyle().Foreground(s.color).Render("■") + " " + s.name
+	}
+	return strings.Join(items, "   ")
+}
+
+func main() {
+	series := []series{
+		{name: "reads", color: "39"},
+		{name: "writes", color: "208"},
+		{name: "deletes", color: "160"},
+	}
+	bars := []bar{
+		{label: "api", values: []float64{1200, 430, 12}},
+		{label: "worker", values: []float64{310, 880, 150}},
+		{label: "cron", values: []float64{40, 95, 0}},
+		{label: "billing", values: []float64{560, 2, 1}},
+		{label: "archive", values: []float64{0, 0, 0}},
+	}
+	p := tea.NewProgram(newModel(series, bars))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
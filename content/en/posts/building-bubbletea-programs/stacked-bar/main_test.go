@@ -0,0 +1,83 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/muesli/termenv"
+)
+
+func TestStackedBar(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	t.Cleanup(func() { lipgloss.SetColorProfile(profile) })
+
+	series := []series{{name: "reads", color: "39"}, {name: "writes", color: "208"}}
+	bars := []bar{
+		{label: "api", values: []float64{30, 10}},
+		{label: "idle", values: []float64{0, 0}},
+	}
+	m := newModel(series, bars)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(60, 10))
+
+	// The widest bar fills the 40 columns left over from the label and
+	// total, three quarters of them reads.
+	reads := lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Render(strings.Repeat("█", 30))
+	writes := lipgloss.NewStyle().Foreground(lipgloss.Color("208")).Render(strings.Repeat("█", 10))
+	waitForStrings(t, tm,
+		"api        "+reads+writes+"       40",
+		"idle       "+emptyStyle.Render("│"),
+		lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Render("■")+" reads",
+		lipgloss.NewStyle().Foreground(lipgloss.Color("208")).Render("■")+" writes",
+	)
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestSegments(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		width  int
+		want   []int
+	}{
+		{"exact", []float64{3, 1}, 8, []int{6, 2}},
+		{"rounding adds up to width", []float64{1, 1, 1}, 10, []int{4, 3, 3}},
+		{"tiny segment keeps a cell", []float64{1000, 1}, 10, []int{9, 1}},
+		{"zero segment stays empty", []float64{5, 0, 5}, 10, []int{5, 0, 5}},
+		{"zero total", []float64{0, 0}, 10, []int{0, 0}},
+		{"not enough room", []float64{10, 1, 1}, 1, []int{1, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := segments(tt.values, tt.width); !slices.Equal(got, tt.want) {
+				t.Errorf("want %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
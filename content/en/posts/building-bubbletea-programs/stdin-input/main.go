@@ -0,0 +1,172 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/term"
+)
+
+var (
+	statusStyle  = lipgloss.NewStyle().Faint(true)
+	warningStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Bold(true)
+)
+
+// inputMsg carries the content read from stdin.
+type inputMsg struct {
+	content []byte
+	err     error
+}
+
+type model struct {
+	// stdin is the piped input, or nil when attached to a terminal, in
+	// which case the content is typed into the textarea instead.
+	stdin io.Reader
+
+	textarea textarea.Model
+	viewport viewport.Model
+	viewing  bool
+	warning  string
+	err      error
+}
+
+func newModel(stdin io.Reader) model {
+	ta := textarea.New()
+	ta.Placeholder = "Nothing was piped, so type or paste something..."
+	ta.ShowLineNumbers = false
+	ta.Focus()
+	return model{stdin: stdin, textarea: ta, viewport: viewport.New(0, 0)}
+}
+
+func (m model) Init() tea.Cmd {
+	if m.stdin != nil {
+		return readInput(m.stdin)
+	}
+	return textarea.Blink
+}
+
+// readInput reads stdin until EOF, which, unlike reading a terminal, is
+// certain to arrive.
+func readInput(r io.Reader) tea.Cmd {
+	return func() tea.Msg {
+		content, err := io.ReadAll(r)
+		return inputMsg{content: content, err: err}
+	}
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "q", "esc":
+			if m.viewing {
+				return m, tea.Quit
+			}
+		case "ctrl+d":
+			if !m.viewing {
+				m.view([]byte(m.textarea.Value()))
+				return m, nil
+			}
+		}
+	case tea.WindowSizeMsg:
+		m.textarea.SetWidth(msg.Width)
+		m.textarea.SetHeight(msg.Height - 1)
+		m.viewport.Width = msg.Width
+		m.viewport.Height = msg.Height - 2
+	case inputMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.view(msg.content)
+		return m, nil
+	}
+	var cmd tea.Cmd
+	if m.viewing {
+		m.viewport, cmd = m.viewport.Update(msg)
+	} else {
+		m.textarea, cmd = m.textarea.Update(msg)
+	}
+	return m, cmd
+}
+
+// view switches to the viewer, showing the content.
+func (m *model) view(content []byte) {
+	m.viewing = true
+	m.textarea.Blur()
+	switch {
+	case len(bytes.TrimSpace(content)) == 0:
+		m.warning = "input is empty"
+	case isBinary(content):
+		// Writing control characters to the terminal can garble it, so
+		// show them escaped.
+		m.warning = "input looks binary, non-printable bytes are shown as '.'"
+		content = printable(content)
+	}
+	m.viewport.SetContent(string(content))
+}
+
+// isBinary reports whether the content looks like binary rather than text,
+// using the same heuristic as many tools: it contains a NUL byte or isn't
+// valid UTF-8.
+func isBinary(content []byte) bool {
+	return bytes.IndexByte(content, 0) >= 0 || !utf8.Valid(content)
+}
+
+func printable(content []byte) []byte {
+	return bytes.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' || (unicode.IsPrint(r) && r != utf8.RuneError) {
+			return r
+		}
+		return '.'
+	}, content)
+}
+
+func (m model) View() string {
+	if m.err != nil {
+		return fmt.Sprintf("could not read stdin: %s\n", m.err)
+	}
+	if !m.viewing {
+		if m.stdin != nil {
+			return "reading stdin...\n"
+		}
+		return m.textarea.View() + "\n" + statusStyle.Render("ctrl+d: view • ctrl+c: quit")
+	}
+	var b strings.Builder
+	if m.warning != "" {
+		b.WriteString(warningStyle.Render("warning: "+m.warning) + "\n")
+	} else {
+		b.WriteString("\n")
+	}
+	b.WriteString(m.viewport.View() + "\n")
+	b.WriteString(statusStyle.Render(fmt.Sprintf("%3.f%% • j/k: scroll • q: quit", m.viewport.ScrollPercent()*100)))
+	return b.String()
+}
+
+func main() {
+	var (
+		stdin io.Reader
+		opts  = []tea.ProgramOption{tea.WithAltScreen()}
+	)
+	if !term.IsTerminal(os.Stdin.Fd()) {
+		// Stdin is piped, so read keys from the terminal instead.
+		stdin = os.Stdin
+		opts = append(opts, tea.WithInputTTY())
+	}
+	p := tea.NewProgram(newModel(stdin), opts...)
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestPiped(t *testing.T) {
+	stdin := strings.NewReader("first line\nsecond line\nthird line\n")
+	tm := teatest.NewTestModel(t, newModel(stdin), teatest.WithInitialTermSize(80, 20))
+
+	waitForStrings(t, tm, "first line", "second line", "third line")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.warning != "" {
+		t.Errorf("unexpected warning: %q", fm.warning)
+	}
+}
+
+func TestPipedEmpty(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(strings.NewReader("")), teatest.WithInitialTermSize(80, 20))
+
+	waitForStrings(t, tm, "warning: input is empty")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestPipedBinary(t *testing.T) {
+	stdin := strings.NewReader("ELF\x00\x01\x02header\xff\xfe")
+	tm := teatest.NewTestModel(t, newModel(stdin), teatest.WithInitialTermSize(80, 20))
+
+	waitForStrings(t, tm, "warning: input looks binary", "ELF...header..")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestInteractive(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(nil), teatest.WithInitialTermSize(80, 20))
+
+	tm.Type("typed by hand")
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlD})
+
+	waitForStrings(t, tm, "j/k: scroll")
+
+	// Unlike in the textarea, q now quits.
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.viewport.View(); !strings.Contains(got, "typed by hand") {
+		t.Errorf("expected typed content in viewer, got %q", got)
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
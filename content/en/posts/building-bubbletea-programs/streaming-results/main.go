@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// maxShown is the number of results shown, the most recent last.
+const maxShown = 10
+
+var (
+	statusStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	successStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+	errorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	helpStyle    = lipgloss.NewStyle().Faint(true)
+)
+
+// computation works out the results for a query, passing each to emit as
+// soon as it's found. It should stop when the context is cancelled.
+type computation func(ctx context.Context, query string, emit func(result string)) error
+
+type state int
+
+const (
+	idle state = iota
+	computing
+	done
+	cancelled
+	failed
+)
+
+type (
+	// resultMsg and doneMsg carry the run they belong to, so that anything
+	// still arriving from a cancelled run is ignored.
+	resultMsg struct {
+		run    int
+		result string
+	}
+	doneMsg struct {
+		run int
+		err error
+	}
+)
+
+type model struct {
+	compute computation
+	input   textinput.Model
+
+	run     int
+	query   string
+	state   state
+	results []string
+	err     error
+	cancel  context.CancelFunc
+	stream  chan tea.Msg
+}
+
+func newModel(compute computation) model {
+	input := textinput.New()
+	input.Prompt = "query: "
+	input.Focus()
+	return model{compute: compute, input: input}
+}
+
+func (m model) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// start runs the computation in the background, sending each result to the
+// stream as it's found, then closing it once the computation has finished.
+func (m *model) start(query string) tea.Cmd {
+	m.stop()
+	m.run++
+	m.query, m.state, m.results, m.err = query, computing, nil, nil
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	m.stream = make(chan tea.Msg)
+
+	run, stream, compute := m.run, m.stream, m.compute
+	go func() {
+		defer close(stream)
+		send := func(msg tea.Msg) {
+			// Once cancelled, nobody is listening.
+			select {
+			case stream <- msg:
+			case <-ctx.Done():
+			}
+		}
+		err := compute(ctx, query, func(result string) {
+			send(resultMsg{run: run, result: result})
+		})
+		send(doneMsg{run: run, err: err})
+	}()
+	return listen(stream)
+}
+
+func (m *model) stop() {
+	if m.cancel != nil {
+		m.cancel()
+		m.cancel = nil
+	}
+}
+
+// listen waits for the next message from the stream.
+func listen(stream <-chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		msg, ok := <-stream
+		if !ok {
+			return nil
+		}
+		return msg
+	}
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			m.stop()
+			return m, tea.Quit
+		case "esc":
+			if m.state == computing {
+				m.stop()
+				m.state = cancelled
+			}
+			return m, nil
+		case "enter":
+			if query := strings.TrimSpace(m.input.Value()); query != "" {
+				return m, m.start(query)
+			}
+			return m, nil
+		}
+	case resultMsg:
+		if msg.run != m.run || m.state != computing {
+			return m, nil
+		}
+		m.results = append(m.results, msg.result)
+		return m, listen(m.stream)
+	case doneMsg:
+		if msg.run != m.run || m.state != computing {
+			return m, nil
+		}
+		// The computation has finished, but its context must still be
+		// cancelled to release it.
+		m.stop()
+		if msg.err != nil {
+			m.state, m.err = failed, msg.err
+		} else {
+			m.state = done
+		}
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+func plural(n int, singular string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, singular)
+	}
+	return fmt.Sprintf("%d %ss", n, singular)
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	b.WriteString(m.input.View() + "\n\n")
+	switch m.state {
+	case idle:
+		b.WriteString("enter a query to search\n")
+	case computing:
+		b.WriteString(statusStyle.Render(fmt.Sprintf("computing… (partial, %s)", plural(len(m.results), "result"))) + "\n")
+	case done:
+		b.WriteString(successStyle.Render(fmt.Sprintf("done: %s for %q", plural(len(m.results), "result"), m.query)) + "\n")
+	case cancelled:
+		b.WriteString(errorStyle.Render(fmt.Sprintf("cancelled: %s", plural(len(m.results), "partial result"))) + "\n")
+	case failed:
+		b.WriteString(errorStyle.Render(fmt.Sprintf("failed after %s: %s", plural(len(m.results), "result"), m.err)) + "\n")
+	}
+	if hidden := len(m.results) - maxShown; hidden > 0 {
+		fmt.Fprintf(&b, "  … %d more\n", hidden)
+	}
+	for _, r := range m.results[max(0, len(m.results)-maxShown):] {
+		b.WriteString("  " + r + "\n")
+	}
+	b.WriteString("\n" + helpStyle.Render("enter: search • esc: cancel • ctrl+c: quit") + "\n")
+	return b.String()
+}
+
+// search slowly scans a large made-up list of files for names containing
+// the query.
+func search(ctx context.Context, query string, emit func(string)) error {
+	dirs := []string{"cmd", "internal/api", "internal/store", "pkg/auth", "web/static"}
+	for i := range 20000 {
+		if i%100 == 0 {
+			select {
+			case <-time.After(20 * time.Millisecond):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		name := fmt.Sprintf("%s/file_%05d.go", dirs[i%len(dirs)], i)
+		if strings.Contains(name, query) {
+			emit(name)
+		}
+	}
+	return nil
+}
+
+func main() {
+	p := tea.NewProgram(newModel(search))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestStreaming(t *testing.T) {
+	// Each result is emitted when the test sends it.
+	feed := make(chan string)
+	compute := func(ctx context.Context, query string, emit func(string)) error {
+		for r := range feed {
+			emit(query + ": " + r)
+		}
+		return nil
+	}
+	tm := teatest.NewTestModel(t, newModel(compute), teatest.WithInitialTermSize(80, 24))
+
+	tm.Type("primes")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForStrings(t, tm, "computing… (partial, 0 results)")
+
+	feed <- "2"
+
+	waitForStrings(t, tm, "computing… (partial, 1 result)", "primes: 2")
+
+	feed <- "3"
+
+	waitForStrings(t, tm, "computing… (partial, 2 results)", "primes: 3")
+
+	close(feed)
+
+	waitForStrings(t, tm, `done: 2 results for "primes"`)
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.state != done || len(fm.results) != 2 {
+		t.Errorf("expected 2 results once done, got state %d with %v", fm.state, fm.results)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	stopped := make(chan error)
+	compute := func(ctx context.Context, query string, emit func(string)) error {
+		emit("first")
+		// Never finishes by itself.
+		<-ctx.Done()
+		stopped <- ctx.Err()
+		return ctx.Err()
+	}
+	tm := teatest.NewTestModel(t, newModel(compute), teatest.WithInitialTermSize(80, 24))
+
+	tm.Type("slow")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForStrings(t, tm, "computing… (partial, 1 result)", "first")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+
+	waitForStrings(t, tm, "cancelled: 1 partial result")
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("expected the computation to be stopped")
+	}
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.state != cancelled {
+		t.Errorf("expected state to remain cancelled, got %d", fm.state)
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
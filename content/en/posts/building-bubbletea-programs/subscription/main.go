@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type (
+	eventMsg  string
+	closedMsg struct{}
+)
+
+type model struct {
+	events <-chan string
+	// done is closed when the program quits, telling the producer to stop.
+	done     chan struct{}
+	received []string
+	closed   bool
+	quitting bool
+}
+
+func newModel(events <-chan string, done chan struct{}) model {
+	return model{events: events, done: done}
+}
+
+func (m model) Init() tea.Cmd {
+	return waitForEvent(m.events)
+}
+
+// waitForEvent returns a command that blocks until the next event arrives on
+// the channel. The model must call it again after each event to keep
+// receiving them.
+func waitForEvent(events <-chan string) tea.Cmd {
+	return func() tea.Msg {
+		event, ok := <-events
+		if !ok {
+			return closedMsg{}
+		}
+		return eventMsg(event)
+	}
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			if !m.quitting {
+				m.quitting = true
+				close(m.done)
+			}
+			return m, tea.Quit
+		}
+	case eventMsg:
+		m.received = append(m.received, string(msg))
+		return m, waitForEvent(m.events)
+	case closedMsg:
+		// Don't re-subscribe: reading from a closed channel would return
+		// immediately, every time, in a busy loop.
+		m.closed = true
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	for _, event := range m.received {
+		fmt.Fprintf(&b, "• %s\n", event)
+	}
+	if m.closed {
+		b.WriteString("\nstream closed\n")
+	} else {
+		fmt.Fprintf(&b, "\nwaiting for events (%d received)...\n", len(m.received))
+	}
+	return b.String()
+}
+
+// produce sends an event every half second until done is closed, at which
+// point it closes the events channel.
+func produce(events chan<- string, done <-chan struct{}) {
+	defer close(events)
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for i := 1; ; i++ {
+		select {
+		case <-done:
+			return
+		case t := <-ticker.C:
+			select {
+			case events <- fmt.Sprintf("event %d at %s", i, t.Format(time.TimeOnly)):
+			case <-done:
+				return
+			}
+		}
+	}
+}
+
+func main() {
+	events := make(chan string)
+	done := make(chan struct{})
+	go produce(events, done)
+
+	p := tea.NewProgram(newModel(events, done))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
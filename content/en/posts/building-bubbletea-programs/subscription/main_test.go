@@ -0,0 +1,84 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestEventsInOrder(t *testing.T) {
+	events := make(chan string)
+	done := make(chan struct{})
+	m := newModel(events, done)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	for _, event := range []string{"first", "second", "third"} {
+		events <- event
+	}
+
+	waitForMatch(t, tm, `(?s)• first.*• second.*• third`)
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+
+	select {
+	case <-done:
+	default:
+		t.Error("expected done channel to be closed on quit")
+	}
+}
+
+func TestChannelClosed(t *testing.T) {
+	events := make(chan string)
+	m := newModel(events, make(chan struct{}))
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	events <- "only"
+	close(events)
+
+	waitForMatch(t, tm, `stream closed`)
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if strings.Join(fm.received, ",") != "only" {
+		t.Errorf("unexpected events received: %v", fm.received)
+	}
+}
+
+func TestProduceTeardown(t *testing.T) {
+	events := make(chan string)
+	done := make(chan struct{})
+	go produce(events, done)
+	close(done)
+
+	select {
+	case _, ok := <-events:
+		if ok {
+			// An event may have been in flight; the channel must still
+			// close shortly after.
+			if _, ok := <-events; ok {
+				t.Error("expected events channel to be closed")
+			}
+		}
+	case <-time.After(time.Second):
+		t.Error("producer did not stop")
+	}
+}
+
+func waitForMatch(t *testing.T, tm *teatest.TestModel, pattern string) {
+	re := regexp.MustCompile(pattern)
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return re.Match(b)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
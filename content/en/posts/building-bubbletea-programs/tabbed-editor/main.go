@@ -0,0 +1,174 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	activeTabStyle   = lipgloss.NewStyle().Reverse(true).Bold(true).Padding(0, 1)
+	inactiveTabStyle = lipgloss.NewStyle().Faint(true).Padding(0, 1)
+	confirmStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Bold(true)
+	helpStyle        = lipgloss.NewStyle().Faint(true)
+)
+
+// tab is an independent buffer, with its own textarea and therefore its
+// own content, cursor and scroll position.
+type tab struct {
+	name     string
+	textarea textarea.Model
+	// saved is the content as it was last saved, to tell whether there are
+	// unsaved changes.
+	saved string
+}
+
+func (t tab) modified() bool {
+	return t.textarea.Value() != t.saved
+}
+
+type model struct {
+	tabs     []tab
+	active   int
+	untitled int
+	// closing is true while asking whether to discard the active tab's
+	// unsaved changes.
+	closing       bool
+	status        string
+	width, height int
+}
+
+func newModel(names ...string) model {
+	m := model{width: 80, height: 24}
+	for _, name := range names {
+		m.tabs = append(m.tabs, m.newTab(name))
+	}
+	if len(m.tabs) == 0 {
+		m.tabs = append(m.tabs, m.newUntitled())
+	}
+	m.tabs[0].textarea.Focus()
+	return m
+}
+
+func (m *model) newTab(name string) tab {
+	ta := textarea.New()
+	ta.SetWidth(m.width)
+	ta.SetHeight(max(1, m.height-3))
+	ta.ShowLineNumbers = false
+	return tab{name: name, textarea: ta}
+}
+
+func (m *model) newUntitled() tab {
+	m.untitled++
+	return m.newTab(fmt.Sprintf("untitled-%d", m.untitled))
+}
+
+func (m model) Init() tea.Cmd {
+	return textarea.Blink
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.closing {
+			m.closing = false
+			if msg.String() == "y" {
+				return m, m.close()
+			}
+			m.status = ""
+			return m, nil
+		}
+		m.status = ""
+		// These take precedence over the textarea's own bindings, such as
+		// ctrl+w to delete a word.
+		switch msg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "tab":
+			return m, m.switchTo((m.active + 1) % len(m.tabs))
+		case "shift+tab":
+			return m, m.switchTo((m.active - 1 + len(m.tabs)) % len(m.tabs))
+		case "ctrl+t":
+			m.tabs = append(m.tabs, m.newUntitled())
+			return m, m.switchTo(len(m.tabs) - 1)
+		case "ctrl+s":
+			t := &m.tabs[m.active]
+			t.saved = t.textarea.Value()
+			m.status = "saved " + t.name
+			return m, nil
+		case "ctrl+w":
+			if m.tabs[m.active].modified() {
+				m.closing = true
+				return m, nil
+			}
+			return m, m.close()
+		}
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		for i := range m.tabs {
+			m.tabs[i].textarea.SetWidth(m.width)
+			m.tabs[i].textarea.SetHeight(max(1, m.height-3))
+		}
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.tabs[m.active].textarea, cmd = m.tabs[m.active].textarea.Update(msg)
+	return m, cmd
+}
+
+// switchTo makes another tab active. The tab being left keeps its content
+// and cursor, and any edit in progress is still there on return.
+func (m *model) switchTo(i int) tea.Cmd {
+	m.tabs[m.active].textarea.Blur()
+	m.active = i
+	return m.tabs[m.active].textarea.Focus()
+}
+
+// close closes the active tab, opening an empty one if that was the last.
+func (m *model) close() tea.Cmd {
+	m.status = "closed " + m.tabs[m.active].name
+	m.tabs = slices.Delete(m.tabs, m.active, m.active+1)
+	if len(m.tabs) == 0 {
+		m.tabs = append(m.tabs, m.newUntitled())
+	}
+	m.active = min(m.active, len(m.tabs)-1)
+	return m.tabs[m.active].textarea.Focus()
+}
+
+func (m model) View() string {
+	names := make([]string, len(m.tabs))
+	for i, t := range m.tabs {
+		name := t.name
+		if t.modified() {
+			name += " ●"
+		}
+		if i == m.active {
+			names[i] = activeTabStyle.Render(name)
+		} else {
+			names[i] = inactiveTabStyle.Render(name)
+		}
+	}
+	var footer string
+	switch {
+	case m.closing:
+		footer = confirmStyle.Render(fmt.Sprintf("Discard unsaved changes to %s? (y/N)", m.tabs[m.active].name))
+	case m.status != "":
+		footer = m.status
+	default:
+		footer = helpStyle.Render("tab/shift+tab: switch • ctrl+t: new • ctrl+s: save • ctrl+w: close • ctrl+c: quit")
+	}
+	return strings.Join(names, " ") + "\n" + m.tabs[m.active].textarea.View() + "\n" + footer
+}
+
+func main() {
+	p := tea.NewProgram(newModel("notes.md", "todo.txt"), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
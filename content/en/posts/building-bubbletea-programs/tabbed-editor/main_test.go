@@ -0,0 +1,84 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestIndependentBuffers(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel("a.txt", "b.txt"), teatest.WithInitialTermSize(80, 12))
+
+	tm.Type("hello")
+
+	waitForStrings(t, tm, "a.txt ●", "hello")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyTab})
+	tm.Type("world")
+
+	waitForStrings(t, tm, "a.txt ●", "b.txt ●", "world")
+
+	// Back to the first tab, with its cursor where it was left.
+	tm.Send(tea.KeyMsg{Type: tea.KeyShiftTab})
+	tm.Type("!")
+
+	waitForStrings(t, tm, "hello!")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	for i, want := range []string{"hello!", "world"} {
+		if got := fm.tabs[i].textarea.Value(); got != want {
+			t.Errorf("tab %d: want %q, got %q", i, want, got)
+		}
+	}
+}
+
+func TestCloseUnsaved(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel("a.txt", "b.txt"), teatest.WithInitialTermSize(80, 12))
+
+	tm.Type("draft")
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlW})
+
+	waitForStrings(t, tm, "Discard unsaved changes to a.txt? (y/N)")
+
+	// Anything but y keeps the tab open.
+	tm.Type("n")
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlS})
+
+	waitForStrings(t, tm, "saved a.txt")
+
+	// Once saved, the tab closes without asking.
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlW})
+
+	waitForStrings(t, tm, "closed a.txt")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if len(fm.tabs) != 1 || fm.tabs[0].name != "b.txt" {
+		t.Errorf("expected only b.txt to be left open, got %d tabs", len(fm.tabs))
+	}
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"unordered/pagedtable"
+)
+
+var columns = []table.Column{
+	{Title: "ID", Width: 4},
+	{Title: "Name", Width: 12},
+	{Title: "Status", Width: 10},
+}
+
+type model struct {
+	table pagedtable.Model
+}
+
+func newModel(rows []table.Row, perPage int) model {
+	return model{table: pagedtable.New(columns, rows, perPage)}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	}
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+func (m model) View() string {
+	return m.table.View() + "\n\n↑/↓: select • ←/→: page • q: quit\n"
+}
+
+func main() {
+	statuses := []string{"running", "stopped", "pending"}
+	var rows []table.Row
+	for i := 1; i <= 23; i++ {
+		rows = append(rows, table.Row{fmt.Sprint(i), fmt.Sprintf("worker-%02d", i), statuses[i%len(statuses)]})
+	}
+	p := tea.NewProgram(newModel(rows, 10))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func rows(n int) []table.Row {
+	var rows []table.Row
+	for i := 1; i <= n; i++ {
+		rows = append(rows, table.Row{fmt.Sprint(i), fmt.Sprintf("row-%02d", i), "ok"})
+	}
+	return rows
+}
+
+func TestPaging(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(rows(12), 5), teatest.WithInitialTermSize(80, 24))
+
+	waitForStrings(t, tm, "row-01", "row-05", "•○○  Page 1/3")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+
+	waitForStrings(t, tm, "row-06", "row-10", "○•○  Page 2/3")
+
+	// The last page only has two rows.
+	tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+	tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+
+	waitForStrings(t, tm, "row-11", "row-12", "○○•  Page 3/3")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	view := fm.View()
+	for _, row := range []string{"row-01", "row-06", "row-10"} {
+		if strings.Contains(view, row) {
+			t.Errorf("expected %s not to be displayed on the last page", row)
+		}
+	}
+	if got := fm.table.SelectedRow()[1]; got != "row-11" {
+		t.Errorf("expected cursor to be on the first row of the page, got %s", got)
+	}
+}
+
+func TestSinglePage(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(rows(3), 5), teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "row-03")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	if view := fm.View(); strings.Contains(view, "Page") {
+		t.Errorf("expected paginator to be hidden, got:\n%s", view)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	waitForStrings(t, tm, s)
+}
+
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,82 @@
+// Package pagedtable provides a table that shows its rows a page at a time.
+package pagedtable
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/paginator"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var footerStyle = lipgloss.NewStyle().Faint(true)
+
+// Model is a table of rows split into pages. The table only ever holds the
+// rows of the current page; the paginator decides which those are.
+type Model struct {
+	rows      []table.Row
+	table     table.Model
+	paginator paginator.Model
+}
+
+// New returns a table showing perPage rows at a time.
+func New(columns []table.Column, rows []table.Row, perPage int) Model {
+	p := paginator.New()
+	p.Type = paginator.Dots
+	p.PerPage = perPage
+	p.SetTotalPages(len(rows))
+
+	m := Model{
+		rows:      rows,
+		paginator: p,
+		table: table.New(
+			table.WithColumns(columns),
+			table.WithFocused(true),
+			// Keep the height constant, so the footer doesn't jump up on
+			// a partial last page. The height includes the header.
+			table.WithHeight(perPage+1),
+		),
+	}
+	m.slice()
+	return m
+}
+
+// slice sets the table's rows to those on the current page.
+func (m *Model) slice() {
+	start, end := m.paginator.GetSliceBounds(len(m.rows))
+	m.table.SetRows(m.rows[start:end])
+	m.table.SetCursor(0)
+}
+
+// SelectedRow returns the row under the cursor.
+func (m Model) SelectedRow() table.Row {
+	return m.table.SelectedRow()
+}
+
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "left", "h", "right", "l":
+			page := m.paginator.Page
+			m.paginator, _ = m.paginator.Update(msg)
+			if m.paginator.Page != page {
+				m.slice()
+			}
+			return m, nil
+		}
+	}
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+func (m Model) View() string {
+	if m.paginator.TotalPages <= 1 {
+		// Everything fits on one page, so there's nothing to paginate.
+		return m.table.View()
+	}
+	footer := fmt.Sprintf("%s  Page %d/%d", m.paginator.View(), m.paginator.Page+1, m.paginator.TotalPages)
+	return m.table.View() + "\n" + footerStyle.Render(footer)
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
+)
+
+// maxTagWidth is the widest a tag is drawn, not counting its border.
+const maxTagWidth = 16
+
+var (
+	chipStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("62")).
+			Padding(0, 1)
+	flashStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	helpStyle  = lipgloss.NewStyle().Faint(true)
+)
+
+// clearFlashMsg clears the flash with the given id, unless it has since been
+// replaced by another.
+type clearFlashMsg struct{ id int }
+
+type model struct {
+	tags    []string
+	input   textinput.Model
+	flash   string
+	flashID int
+}
+
+func newModel() model {
+	input := textinput.New()
+	input.Prompt = "tag: "
+	input.Placeholder = "type a tag, then enter or comma"
+	input.Focus()
+	return model{input: input}
+}
+
+func (m model) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case clearFlashMsg:
+		if msg.id == m.flashID {
+			m.flash = ""
+		}
+		return m, nil
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "esc":
+			return m, tea.Quit
+		case "enter", ",":
+			return m, m.add()
+		case "backspace":
+			// Backspace deletes characters until the input is empty, and
+			// then starts on the tags.
+			if m.input.Value() == "" && len(m.tags) > 0 {
+				m.tags = m.tags[:len(m.tags)-1]
+				return m, nil
+			}
+		}
+	}
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+// add turns the input into a tag, unless it's empty or the same as a tag
+// that's already been added, ignoring case.
+func (m *model) add() tea.Cmd {
+	tag := strings.TrimSpace(m.input.Value())
+	if tag == "" {
+		return nil
+	}
+	if slices.ContainsFunc(m.tags, func(t string) bool { return strings.EqualFold(t, tag) }) {
+		return m.setFlash(fmt.Sprintf("%q has already been added", tag))
+	}
+	m.tags = append(m.tags, tag)
+	m.input.SetValue("")
+	return nil
+}
+
+func (m *model) setFlash(s string) tea.Cmd {
+	m.flash = s
+	m.flashID++
+	id := m.flashID
+	return tea.Tick(2*time.Second, func(time.Time) tea.Msg {
+		return clearFlashMsg{id: id}
+	})
+}
+
+func chip(tag string) string {
+	// The tag itself is kept in full; only the chip is shortened.
+	return chipStyle.Render(truncate.StringWithTail(tag, maxTagWidth, "…"))
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	if len(m.tags) > 0 {
+		chips := make([]string, len(m.tags))
+		for i, tag := range m.tags {
+			chips[i] = chip(tag)
+		}
+		b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, chips...) + "\n")
+	} else {
+		b.WriteString("\nno tags\n\n")
+	}
+	b.WriteString(m.input.View() + "\n")
+	if m.flash != "" {
+		b.WriteString(flashStyle.Render(m.flash) + "\n")
+	}
+	b.WriteString(helpStyle.Render("enter/,: add • backspace: remove last • esc: quit") + "\n")
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
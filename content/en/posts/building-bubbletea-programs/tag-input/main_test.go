@@ -0,0 +1,60 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestTags(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(), teatest.WithInitialTermSize(80, 24))
+
+	tm.Type("go")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+	tm.Type("rust,")
+
+	waitForString(t, tm, "│ go ││ rust │")
+
+	// Adding the same tag again is refused.
+	tm.Type("Go,")
+
+	waitForString(t, tm, `"Go" has already been added`)
+
+	// The first backspace deletes the rejected text; once the input is
+	// empty, the next removes the last tag.
+	for range 3 {
+		tm.Send(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+
+	waitForString(t, tm, "│ go │")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if want := []string{"go"}; !slices.Equal(fm.tags, want) {
+		t.Errorf("want tags %v, got %v", want, fm.tags)
+	}
+}
+
+func TestLongTag(t *testing.T) {
+	tag := "a-very-long-tag-name-indeed"
+	if got := chip(tag); !strings.Contains(got, "│ a-very-long-tag… │") {
+		t.Errorf("expected tag to be truncated, got:\n%s", got)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type tickMsg struct{}
+
+// model counts ticks forever: it only finishes when told to quit.
+type model struct {
+	interval time.Duration
+	ticks    int
+	// locked ignores the quit key, like a program in the middle of
+	// something it won't abandon.
+	locked bool
+}
+
+func newModel(interval time.Duration) model {
+	return model{interval: interval}
+}
+
+func (m model) Init() tea.Cmd {
+	return m.tick()
+}
+
+func (m model) tick() tea.Cmd {
+	return tea.Tick(m.interval, func(time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q":
+			if !m.locked {
+				return m, tea.Quit
+			}
+		case "l":
+			m.locked = !m.locked
+		case "r":
+			m.ticks = 0
+		}
+	case tickMsg:
+		m.ticks++
+		return m, m.tick()
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	status := "q: quit"
+	if m.locked {
+		status = "locked: q is ignored"
+	}
+	return fmt.Sprintf("ticks: %d\n\nr: reset • l: lock • %s\n", m.ticks, status)
+}
+
+func main() {
+	p := tea.NewProgram(newModel(time.Second))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestCleanFinish(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(10*time.Millisecond), teatest.WithInitialTermSize(80, 24))
+
+	// The program never stops ticking, so wait for states along the way
+	// rather than for it to finish.
+	waitForMatch(t, tm, `ticks: [3-9]`)
+
+	tm.Type("r")
+
+	waitForMatch(t, tm, `ticks: [0-2](\D|$)`)
+
+	// Without this, the program carries on ticking, and WaitFinished and
+	// FinalModel wait for it forever, or until the test binary's own
+	// -timeout panics, 10 minutes by default.
+	tm.Type("q")
+
+	// WithFinalTimeout bounds the wait: if the program hasn't finished
+	// within the timeout, the test fails straight away.
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestQuitKeyIgnored(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(10*time.Millisecond), teatest.WithInitialTermSize(80, 24))
+
+	tm.Type("l")
+
+	waitForMatch(t, tm, `locked: q is ignored`)
+
+	tm.Type("q")
+
+	// The program keeps going after the quit key.
+	waitForMatch(t, tm, `ticks: \d+`)
+
+	// Only the first call waits: once WaitFinished or FinalModel has
+	// returned, or timed out, later calls return at once. WithTimeoutFn
+	// replaces the failure on timeout, here to confirm the program is
+	// still running.
+	var timedOut bool
+	tm.WaitFinished(t, teatest.WithFinalTimeout(100*time.Millisecond), teatest.WithTimeoutFn(func(testing.TB) {
+		timedOut = true
+	}))
+	if !timedOut {
+		t.Fatal("expected the program to ignore the quit key")
+	}
+
+	// Quit stops the program from outside, whatever the model does with
+	// keys. It doesn't wait for the program to finish.
+	if err := tm.Quit(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-done(tm):
+	case <-time.After(time.Second):
+		t.Fatal("expected the program to quit")
+	}
+}
+
+// done closes the returned channel once the program has exited.
+func done(tm *teatest.TestModel) <-chan struct{} {
+	ch := make(chan struct{})
+	go func() {
+		tm.GetProgram().Wait()
+		close(ch)
+	}()
+	return ch
+}
+
+func waitForMatch(t *testing.T, tm *teatest.TestModel, pattern string) {
+	re := regexp.MustCompile(pattern)
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return re.Match(b)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// kittyChunkSize is the maximum payload size of a single Kitty graphics
+// escape sequence.
+const kittyChunkSize = 4096
+
+type model struct {
+	img      image.Image
+	graphics bool
+}
+
+func newModel(img image.Image) model {
+	return model{img: img, graphics: supportsGraphics()}
+}
+
+// supportsGraphics makes a best guess as to whether the terminal understands
+// the Kitty graphics protocol. There is no reliable way to query this without
+// reading a response from the terminal, so rely on well-known environment
+// variables instead.
+func supportsGraphics() bool {
+	if os.Getenv("KITTY_WINDOW_ID") != "" || os.Getenv("TERM") == "xterm-kitty" {
+		return true
+	}
+	switch os.Getenv("TERM_PROGRAM") {
+	case "WezTerm", "ghostty":
+		return true
+	}
+	return false
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "g":
+			m.graphics = !m.graphics
+		}
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	if m.graphics {
+		b.WriteString("Mode: kitty graphics\n\n")
+		b.WriteString(kittyImage(m.img))
+	} else {
+		b.WriteString("Mode: unicode blocks\n\n")
+		b.WriteString(blockImage(m.img))
+	}
+	b.WriteString("\n\ng: toggle graphics • q: quit\n")
+	return b.String()
+}
+
+// kittyImage encodes img as a PNG and wraps it in Kitty graphics protocol
+// escape sequences, splitting the payload into chunks.
+func kittyImage(img image.Image) string {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return "error encoding image: " + err.Error()
+	}
+	payload := base64.StdEncoding.EncodeToString(buf.Bytes())
+
+	var b strings.Builder
+	for i := 0; i < len(payload); i += kittyChunkSize {
+		end := min(i+kittyChunkSize, len(payload))
+		more := 1
+		if end == len(payload) {
+			more = 0
+		}
+		if i == 0 {
+			fmt.Fprintf(&b, "\x1b_Gf=100,a=T,m=%d;%s\x1b\\", more, payload[i:end])
+		} else {
+			fmt.Fprintf(&b, "\x1b_Gm=%d;%s\x1b\\", more, payload[i:end])
+		}
+	}
+	return b.String()
+}
+
+// blockImage approximates img using upper half block characters, where the
+// foreground colours the top pixel and the background the bottom pixel, so
+// each cell represents two rows of pixels.
+func blockImage(img image.Image) string {
+	bounds := img.Bounds()
+	rows := make([]string, 0, (bounds.Dy()+1)/2)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += 2 {
+		var row strings.Builder
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			style := lipgloss.NewStyle().Foreground(hex(img.At(x, y)))
+			if y+1 < bounds.Max.Y {
+				style = style.Background(hex(img.At(x, y+1)))
+			}
+			row.WriteString(style.Render("▀"))
+		}
+		rows = append(rows, row.String())
+	}
+	return strings.Join(rows, "\n")
+}
+
+func hex(c color.Color) lipgloss.Color {
+	r, g, b, _ := c.RGBA()
+	return lipgloss.Color(fmt.Sprintf("#%02x%02x%02x", r>>8, g>>8, b>>8))
+}
+
+// gradient generates a small image so the example has no file dependencies.
+func gradient(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{
+				R: uint8(255 * x / (w - 1)),
+				G: uint8(255 * y / (h - 1)),
+				B: 160,
+				A: 255,
+			})
+		}
+	}
+	return img
+}
+
+func main() {
+	p := tea.NewProgram(newModel(gradient(16, 16)))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
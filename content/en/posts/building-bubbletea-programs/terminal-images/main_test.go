@@ -0,0 +1,57 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestFallback(t *testing.T) {
+	m := model{img: gradient(4, 4), graphics: false}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			if strings.Contains(string(b), "\x1b_G") {
+				t.Fatal("unexpected kitty graphics escape sequence")
+			}
+			return strings.Contains(string(b), "Mode: unicode blocks") &&
+				strings.Contains(string(b), "▀▀▀▀")
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestGraphics(t *testing.T) {
+	m := model{img: gradient(4, 4), graphics: false}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	tm.Type("g")
+
+	waitForString(t, tm, "\x1b_Gf=100,a=T,m=0;")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
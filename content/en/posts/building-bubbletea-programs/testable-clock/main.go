@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type tickMsg struct{}
+
+type model struct {
+	// now returns the current time. Tests replace it with a fake clock so
+	// that the rendered times are deterministic.
+	now      func() time.Time
+	deadline time.Time
+}
+
+func newModel(now func() time.Time, countdown time.Duration) model {
+	return model{now: now, deadline: now().Add(countdown)}
+}
+
+func (m model) Init() tea.Cmd {
+	return tick()
+}
+
+func tick() tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case tickMsg:
+		if m.remaining() == 0 {
+			return m, nil
+		}
+		return m, tick()
+	}
+	return m, nil
+}
+
+func (m model) remaining() time.Duration {
+	return max(0, m.deadline.Sub(m.now()))
+}
+
+func (m model) View() string {
+	remaining := m.remaining().Round(time.Second)
+	status := fmt.Sprintf("%02d:%02d", int(remaining.Minutes()), int(remaining.Seconds())%60)
+	if remaining == 0 {
+		status = "time's up!"
+	}
+	return fmt.Sprintf("time:      %s\nremaining: %s\n", m.now().Format(time.TimeOnly), status)
+}
+
+func main() {
+	// The real program uses the real clock.
+	p := tea.NewProgram(newModel(time.Now, time.Minute))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+// fakeClock is a clock that only moves when told to.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestCountdown(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 8, 25, 12, 0, 0, 0, time.UTC)}
+	m := newModel(clock.Now, time.Minute)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "time:      12:00:00\r\nremaining: 01:00")
+
+	clock.Advance(10 * time.Second)
+	tm.Send(tickMsg{})
+
+	waitForString(t, tm, "time:      12:00:10\r\nremaining: 00:50")
+
+	clock.Advance(90 * time.Second)
+	tm.Send(tickMsg{})
+
+	waitForString(t, tm, "time:      12:01:40\r\nremaining: time's up!")
+
+	tm.Type("q")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
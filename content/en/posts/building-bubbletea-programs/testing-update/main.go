@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// savedMsg reports that the count was saved.
+type savedMsg struct{ count int }
+
+type errMsg struct{ err error }
+
+type model struct {
+	count  int
+	status string
+	// save persists the count, and logf records what happened. Tests
+	// replace both.
+	save func(int) error
+	logf func(format string, args ...any)
+}
+
+func newModel(save func(int) error, logf func(string, ...any)) model {
+	return model{save: save, logf: logf}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "+":
+			m.count++
+		case "down", "-":
+			// The count can't go negative.
+			m.count = max(0, m.count-1)
+		case "r":
+			m.count = 0
+			// Resetting is logged, but nothing needs to know when that's
+			// done, so the command returns no message.
+			return m, m.logReset()
+		case "s":
+			m.status = "saving..."
+			return m, m.saveCount()
+		}
+	case savedMsg:
+		m.status = fmt.Sprintf("saved %d", msg.count)
+	case errMsg:
+		m.status = "error: " + msg.err.Error()
+	}
+	return m, nil
+}
+
+func (m model) saveCount() tea.Cmd {
+	// Capture the count now; the model may have changed by the time the
+	// command runs.
+	count := m.count
+	return func() tea.Msg {
+		if err := m.save(count); err != nil {
+			return errMsg{err}
+		}
+		return savedMsg{count: count}
+	}
+}
+
+func (m model) logReset() tea.Cmd {
+	return func() tea.Msg {
+		m.logf("count reset")
+		return nil
+	}
+}
+
+func (m model) View() string {
+	s := fmt.Sprintf("count: %d\n", m.count)
+	if m.status != "" {
+		s += m.status + "\n"
+	}
+	return s + "\n+/-: change • r: reset • s: save • q: quit\n"
+}
+
+func main() {
+	save := func(count int) error {
+		return os.WriteFile("count.txt", []byte(fmt.Sprint(count)), 0o644)
+	}
+	m := newModel(save, func(format string, args ...any) {
+		fmt.Fprintf(os.Stderr, format+"\n", args...)
+	})
+	p := tea.NewProgram(m)
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestUpdate(t *testing.T) {
+	errDiskFull := errors.New("disk full")
+
+	tests := []struct {
+		name  string
+		model model
+		msg   tea.Msg
+		// wantCount and wantStatus are the fields expected after the
+		// update.
+		wantCount  int
+		wantStatus string
+		// wantMsg is the message the returned command produces. If
+		// noCmd is set, no command should be returned at all.
+		wantMsg tea.Msg
+		noCmd   bool
+	}{
+		{
+			name:      "increment",
+			model:     model{count: 1},
+			msg:       tea.KeyMsg{Type: tea.KeyUp},
+			wantCount: 2,
+			noCmd:     true,
+		},
+		{
+			name:      "decrement",
+			model:     model{count: 1},
+			msg:       tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("-")},
+			wantCount: 0,
+			noCmd:     true,
+		},
+		{
+			name:      "decrement below zero",
+			model:     model{count: 0},
+			msg:       tea.KeyMsg{Type: tea.KeyDown},
+			wantCount: 0,
+			noCmd:     true,
+		},
+		{
+			name:      "reset",
+			model:     model{count: 5, logf: func(string, ...any) {}},
+			msg:       tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")},
+			wantCount: 0,
+			// The command runs but returns no message.
+			wantMsg: nil,
+		},
+		{
+			name:       "save",
+			model:      model{count: 3, save: func(int) error { return nil }},
+			msg:        tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")},
+			wantCount:  3,
+			wantStatus: "saving...",
+			wantMsg:    savedMsg{count: 3},
+		},
+		{
+			name:       "save fails",
+			model:      model{count: 3, save: func(int) error { return errDiskFull }},
+			msg:        tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")},
+			wantCount:  3,
+			wantStatus: "saving...",
+			wantMsg:    errMsg{errDiskFull},
+		},
+		{
+			name:       "saved",
+			model:      model{count: 3, status: "saving..."},
+			msg:        savedMsg{count: 3},
+			wantCount:  3,
+			wantStatus: "saved 3",
+			noCmd:      true,
+		},
+		{
+			name:       "error",
+			model:      model{count: 3, status: "saving..."},
+			msg:        errMsg{errDiskFull},
+			wantCount:  3,
+			wantStatus: "error: disk full",
+			noCmd:      true,
+		},
+		{
+			name:      "quit",
+			model:     model{count: 3},
+			msg:       tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")},
+			wantCount: 3,
+			wantMsg:   tea.QuitMsg{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated, cmd := tt.model.Update(tt.msg)
+
+			got := updated.(model)
+			if got.count != tt.wantCount {
+				t.Errorf("count: want %d, got %d", tt.wantCount, got.count)
+			}
+			if got.status != tt.wantStatus {
+				t.Errorf("status: want %q, got %q", tt.wantStatus, got.status)
+			}
+
+			if tt.noCmd {
+				if cmd != nil {
+					t.Errorf("want no command, got one producing %#v", cmd())
+				}
+				return
+			}
+			if cmd == nil {
+				t.Fatal("want a command, got nil")
+			}
+			// Commands are just functions, so call it to get its message.
+			if msg := cmd(); !reflect.DeepEqual(msg, tt.wantMsg) {
+				t.Errorf("message: want %#v, got %#v", tt.wantMsg, msg)
+			}
+		})
+	}
+}
+
+// TestResetLogs checks the reset command's side effect, since its message
+// alone can't show it did anything.
+func TestResetLogs(t *testing.T) {
+	var logged []string
+	m := model{count: 5, logf: func(format string, args ...any) {
+		logged = append(logged, fmt.Sprintf(format, args...))
+	}}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if cmd == nil {
+		t.Fatal("want a command, got nil")
+	}
+	if len(logged) != 0 {
+		t.Fatal("expected nothing logged until the command runs")
+	}
+	if msg := cmd(); msg != nil {
+		t.Errorf("want nil message, got %#v", msg)
+	}
+	if !reflect.DeepEqual(logged, []string{"count reset"}) {
+		t.Errorf("unexpected log: %q", logged)
+	}
+}
+
+// TestSaveCapturesCount checks the save command saves the count as it was
+// when the key was pressed, not when the command runs.
+func TestSaveCapturesCount(t *testing.T) {
+	var saved int
+	m := model{count: 1, save: func(count int) error {
+		saved = count
+		return nil
+	}}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	// Change the count before the command runs.
+	updated, _ = updated.Update(tea.KeyMsg{Type: tea.KeyUp})
+
+	msg := cmd()
+	if saved != 1 {
+		t.Errorf("expected 1 to be saved, got %d", saved)
+	}
+	updated, _ = updated.Update(msg)
+	if got := updated.View(); got != "count: 2\nsaved 1\n\n+/-: change • r: reset • s: save • q: quit\n" {
+		t.Errorf("unexpected view: %q", got)
+	}
+}
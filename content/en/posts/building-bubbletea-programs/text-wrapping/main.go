@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/wordwrap"
+	"github.com/muesli/reflow/wrap"
+)
+
+const (
+	minWidth = 5
+	maxWidth = 60
+)
+
+const paragraph = "Bubbletea programs are built from a model, an update function and a view. " +
+	"The view returns a string, and it is up to you to wrap that string so it fits " +
+	"inside the space available in the terminal."
+
+var columnStyle = lipgloss.NewStyle().
+	Border(lipgloss.RoundedBorder()).
+	Padding(0, 1)
+
+type model struct {
+	text  string
+	width int
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "left":
+			m.width = max(minWidth, m.width-1)
+		case "right":
+			m.width = min(maxWidth, m.width+1)
+		}
+	}
+	return m, nil
+}
+
+// wordWrap breaks lines on word boundaries. A word longer than the width
+// cannot be wrapped on a boundary, so it is then hard wrapped as a last
+// resort to keep it within the column.
+func wordWrap(s string, width int) string {
+	return wrap.String(wordwrap.String(s, width), width)
+}
+
+// charWrap breaks lines at exactly the width, regardless of words.
+func charWrap(s string, width int) string {
+	return wrap.String(s, width)
+}
+
+func (m model) View() string {
+	word := columnStyle.Width(m.width + 2).Render(wordWrap(m.text, m.width))
+	char := columnStyle.Width(m.width + 2).Render(charWrap(m.text, m.width))
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		fmt.Sprintf("width: %d", m.width),
+		lipgloss.JoinHorizontal(lipgloss.Top,
+			lipgloss.JoinVertical(lipgloss.Left, "word wrap", word),
+			" ",
+			lipgloss.JoinVertical(lipgloss.Left, "char wrap", char),
+		),
+		"←/→: adjust width • q: quit",
+	)
+}
+
+func main() {
+	p := tea.NewProgram(model{text: paragraph, width: 30})
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
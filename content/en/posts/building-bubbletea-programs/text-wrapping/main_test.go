@@ -0,0 +1,79 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestWrapping(t *testing.T) {
+	m := model{text: paragraph, width: 14}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 40))
+
+	waitForString(t, tm, "width: 14")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyLeft})
+	tm.Send(tea.KeyMsg{Type: tea.KeyLeft})
+
+	waitForString(t, tm, "width: 12")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+
+	words := make(map[string]bool)
+	for _, w := range strings.Fields(paragraph) {
+		words[w] = true
+	}
+
+	for _, line := range strings.Split(wordWrap(fm.text, fm.width), "\n") {
+		if lipgloss.Width(line) > fm.width {
+			t.Errorf("word wrapped line %q exceeds width %d", line, fm.width)
+		}
+		for _, w := range strings.Fields(line) {
+			if !words[w] {
+				t.Errorf("word wrapped line %q breaks inside a word", line)
+			}
+		}
+	}
+
+	var broken bool
+	for _, line := range strings.Split(charWrap(fm.text, fm.width), "\n") {
+		if lipgloss.Width(line) > fm.width {
+			t.Errorf("char wrapped line %q exceeds width %d", line, fm.width)
+		}
+		for _, w := range strings.Fields(line) {
+			if !words[w] {
+				broken = true
+			}
+		}
+	}
+	if !broken {
+		t.Error("expected char wrapped lines to break inside a word")
+	}
+}
+
+func TestLongWord(t *testing.T) {
+	const word = "supercalifragilisticexpialidocious"
+	for _, line := range strings.Split(wordWrap(word, 10), "\n") {
+		if lipgloss.Width(line) > 10 {
+			t.Errorf("line %q exceeds width 10", line)
+		}
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
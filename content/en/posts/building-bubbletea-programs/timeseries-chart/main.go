@@ -0,0 +1,247 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// minWindow is the fewest points that can be zoomed in to.
+const minWindow = 2
+
+var (
+	lineStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("39"))
+	axisStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	statusStyle = lipgloss.NewStyle().Faint(true)
+)
+
+type point struct {
+	t time.Time
+	v float64
+}
+
+// canvas is a grid of braille characters, each of which is two dots wide
+// and four tall.
+type canvas struct {
+	width, height int
+	cells         [][]rune
+}
+
+// dots are the bits that set each dot of a braille character, by row and
+// column within it.
+var dots = [4][2]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+func newCanvas(width, height int) *canvas {
+	c := &canvas{width: width, height: height, cells: make([][]rune, height)}
+	for y := range c.cells {
+		c.cells[y] = make([]rune, width)
+	}
+	return c
+}
+
+// set sets the dot at x, y, where the canvas is width*2 dots across and
+// height*4 dots down.
+func (c *canvas) set(x, y int) {
+	if x < 0 || y < 0 || x >= c.width*2 || y >= c.height*4 {
+		return
+	}
+	c.cells[y/4][x/2] |= dots[y%4][x%2]
+}
+
+// line draws a line between two dots, using Bresenham's algorithm.
+func (c *canvas) line(x0, y0, x1, y1 int) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	for err := dx + dy; ; {
+		c.set(x0, y0)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		if e2 := 2 * err; e2 >= dy {
+			err += dy
+			x0 += sx
+		} else {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func (c *canvas) rows() []string {
+	rows := make([]string, c.height)
+	for y, row := range c.cells {
+		var b strings.Builder
+		for _, bits := range row {
+			if bits == 0 {
+				b.WriteRune(' ')
+			} else {
+				b.WriteRune(0x2800 + bits)
+			}
+		}
+		rows[y] = b.String()
+	}
+	return rows
+}
+
+// plot draws the points across the whole canvas, scaled between their
+// minimum and maximum values.
+func plot(points []point, width, height int) []string {
+	c := newCanvas(width, height)
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, p := range points {
+		lo, hi = min(lo, p.v), max(hi, p.v)
+	}
+	xOf := func(i int) int {
+		if len(points) == 1 {
+			return 0
+		}
+		// Spread the points across the width, however few there are.
+		return i * (width*2 - 1) / (len(points) - 1)
+	}
+	yOf := func(v float64) int {
+		if hi == lo {
+			// A flat series is drawn across the middle.
+			return height * 2
+		}
+		return int(math.Round((hi - v) / (hi - lo) * float64(height*4-1)))
+	}
+	for i, p := range points {
+		if i == 0 {
+			c.set(xOf(i), yOf(p.v))
+			continue
+		}
+		c.line(xOf(i-1), yOf(points[i-1].v), xOf(i), yOf(p.v))
+	}
+	return c.rows()
+}
+
+type model struct {
+	points []point
+	// start and size are the first point and number of points in the
+	// visible window.
+	start, size   int
+	width, height int
+}
+
+func newModel(points []point) model {
+	return model{points: points, size: len(points), width: 80, height: 24}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "+", "=":
+			m.zoom(m.size / 2)
+		case "-":
+			m.zoom(m.size * 2)
+		case "left", "h":
+			m.pan(-max(1, m.size/4))
+		case "right", "l":
+			m.pan(max(1, m.size/4))
+		case "0":
+			m.start, m.size = 0, len(m.points)
+		}
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+	}
+	return m, nil
+}
+
+// zoom resizes the window, keeping it centered on the same point.
+func (m *model) zoom(size int) {
+	size = max(min(minWindow, len(m.points)), min(len(m.points), size))
+	center := m.start + m.size/2
+	m.size = size
+	m.start = center - size/2
+	m.pan(0)
+}
+
+// pan moves the window, stopping at either end of the series.
+func (m *model) pan(delta int) {
+	m.start = max(0, min(len(m.points)-m.size, m.start+delta))
+}
+
+func (m model) visible() []point {
+	return m.points[m.start : m.start+m.size]
+}
+
+func (m model) View() string {
+	if len(m.points) == 0 {
+		return "no data\n"
+	}
+	visible := m.visible()
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, p := range visible {
+		lo, hi = min(lo, p.v), max(hi, p.v)
+	}
+	const labelWidth = 8
+	height := max(1, m.height-3)
+	width := max(1, m.width-labelWidth-1)
+
+	var b strings.Builder
+	for y, row := range plot(visible, width, height) {
+		label := ""
+		switch y {
+		case 0:
+			label = fmt.Sprintf("%.1f", hi)
+		case height - 1:
+			label = fmt.Sprintf("%.1f", lo)
+		}
+		b.WriteString(axisStyle.Render(fmt.Sprintf("%*s┤", labelWidth, label)) + lineStyle.Render(row) + "\n")
+	}
+	from, to := visible[0].t.Format("15:04"), visible[len(visible)-1].t.Format("15:04")
+	b.WriteString(strings.Repeat(" ", labelWidth+1) + from + strings.Repeat(" ", max(1, width-len(from)-len(to))) + to + "\n")
+	b.WriteString(statusStyle.Render(fmt.Sprintf("%d of %d points • +/-: zoom • ←/→: pan • 0: reset • q: quit", m.size, len(m.points))))
+	return b.String()
+}
+
+func series(start time.Time, n int) []point {
+	points := make([]point, n)
+	for i := range points {
+		x := float64(i)
+		points[i] = point{
+			t: start.Add(time.Duration(i) * time.Minute),
+			v: 50 + 20*math.Sin(x/15) + 8*math.Sin(x/3.7),
+		}
+	}
+	return points
+}
+
+func main() {
+	points := series(time.Now().Add(-8*time.Hour).Truncate(time.Minute), 480)
+	p := tea.NewProgram(newModel(points), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+var epoch = time.Date(2024, 8, 25, 12, 0, 0, 0, time.UTC)
+
+func TestZoom(t *testing.T) {
+	m := newModel(series(epoch, 120))
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(60, 15))
+
+	waitForStrings(t, tm, "12:00", "13:59", "120 of 120 points")
+
+	// Zooming in halves the window around its center.
+	tm.Type("+")
+
+	waitForStrings(t, tm, "12:30", "13:29", "60 of 120 points")
+
+	tm.Type("+")
+
+	waitForStrings(t, tm, "12:45", "13:14", "30 of 120 points")
+
+	// Pan right by a quarter of the window. The number of points shown
+	// doesn't change, so that line isn't redrawn and isn't waited for.
+	tm.Type("l")
+
+	waitForStrings(t, tm, "12:52", "13:21")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.start != 52 || fm.size != 30 {
+		t.Errorf("unexpected window: start %d, size %d", fm.start, fm.size)
+	}
+}
+
+func TestZoomLimits(t *testing.T) {
+	m := newModel(series(epoch, 10))
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(60, 15))
+
+	tm.Type("++++++")
+
+	waitForString(t, tm, "2 of 10 points")
+
+	tm.Type("llllll------")
+
+	waitForString(t, tm, "10 of 10 points")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.start != 0 {
+		t.Errorf("expected window to start at the beginning, got %d", fm.start)
+	}
+}
+
+func TestPlotFewPoints(t *testing.T) {
+	// Far fewer points than columns: the line still spans the width.
+	rows := plot([]point{{v: 0}, {v: 10}, {v: 0}}, 20, 3)
+	if rows[2][0] == ' ' {
+		t.Errorf("expected first column to be drawn:\n%s", strings.Join(rows, "\n"))
+	}
+	if r := []rune(rows[2]); r[len(r)-1] == ' ' {
+		t.Errorf("expected last column to be drawn:\n%s", strings.Join(rows, "\n"))
+	}
+	if r := []rune(rows[0]); r[10] == ' ' {
+		t.Errorf("expected peak in the middle:\n%s", strings.Join(rows, "\n"))
+	}
+}
+
+func TestPlotFlat(t *testing.T) {
+	points := []point{{v: 5}, {v: 5}, {v: 5}, {v: 5}}
+	rows := plot(points, 4, 3)
+	// A flat line through the middle, rather than a division by zero.
+	want := []string{"    ", "⠤⠤⠤⠤", "    "}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Errorf("row %d: want %q, got %q", i, want[i], rows[i])
+		}
+	}
+	// A single point is drawn too.
+	if rows := plot(points[:1], 4, 3); rows[1] != "⠄   " {
+		t.Errorf("unexpected single point: %q", rows)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	waitForStrings(t, tm, s)
+}
+
+// waitForStrings waits until all of the given strings appear in the same
+// chunk of output.
+func waitForStrings(t *testing.T, tm *teatest.TestModel, ss ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			for _, s := range ss {
+				if !strings.Contains(string(b), s) {
+					return false
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
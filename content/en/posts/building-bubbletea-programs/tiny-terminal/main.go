@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	minWidth  = 40
+	minHeight = 10
+)
+
+type model struct {
+	width, height int
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case msg.String() == "ctrl+c":
+			return m, tea.Quit
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	// The size is unknown until the first WindowSizeMsg arrives, so there is
+	// nothing sensible to lay out yet.
+	if m.width == 0 || m.height == 0 {
+		return ""
+	}
+	if m.width < minWidth || m.height < minHeight {
+		return m.tooSmallView()
+	}
+
+	header := lipgloss.NewStyle().
+		Align(lipgloss.Center).
+		Width(m.width).
+		Border(lipgloss.NormalBorder(), false, false, true, false).
+		Render("header")
+	footer := lipgloss.NewStyle().
+		Align(lipgloss.Center).
+		Width(m.width).
+		Render(fmt.Sprintf("footer (%dx%d)", m.width, m.height))
+
+	content := lipgloss.NewStyle().
+		Width(m.width).
+		Height(m.height-lipgloss.Height(header)-lipgloss.Height(footer)).
+		Align(lipgloss.Center, lipgloss.Center).
+		Render("content")
+
+	return lipgloss.JoinVertical(lipgloss.Top, header, content, footer)
+}
+
+func (m model) tooSmallView() string {
+	msg := lipgloss.NewStyle().
+		Width(m.width).
+		MaxHeight(m.height).
+		Align(lipgloss.Center).
+		Render(fmt.Sprintf("terminal too small\nresize to at least %dx%d", minWidth, minHeight))
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, msg)
+}
+
+func main() {
+	p := tea.NewProgram(model{}, tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestTooSmall(t *testing.T) {
+	m := model{}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(20, 5))
+
+	waitForString(t, tm, "terminal too small")
+
+	tm.Send(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	waitForString(t, tm, "footer (80x24)")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlC})
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+}
+
+func TestZeroSize(t *testing.T) {
+	if got := (model{}).View(); got != "" {
+		t.Errorf("expected empty view before first WindowSizeMsg, got %q", got)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
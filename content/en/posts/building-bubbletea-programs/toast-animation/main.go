@@ -0,0 +1,182 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	toastWidth = 30
+	// toastHeight is the height of a toast, including its border.
+	toastHeight = 3
+)
+
+var (
+	toastStyle  = lipgloss.NewStyle().Width(toastWidth-2).Border(lipgloss.RoundedBorder()).Padding(0, 1)
+	statusStyle = lipgloss.NewStyle().Faint(true)
+)
+
+type toast struct {
+	id   int
+	text string
+	// y is the row the toast is currently drawn at, and target is the row
+	// it is moving towards, one row per tick.
+	y, target int
+	dismissed bool
+}
+
+type tickMsg struct{}
+
+type model struct {
+	interval      time.Duration
+	toasts        []toast
+	nextID        int
+	width, height int
+	// animating is true while a tick is scheduled, so that starting an
+	// animation while another is in progress doesn't start a second tick
+	// loop.
+	animating bool
+}
+
+func newModel(interval time.Duration) model {
+	return model{interval: interval}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+// canvasHeight is the height of the area the toasts are stacked in.
+func (m model) canvasHeight() int {
+	return max(0, m.height-1)
+}
+
+// layout sets the target of each toast that hasn't been dismissed, stacking
+// them upwards from the bottom of the canvas, oldest at the top.
+func (m *model) layout() {
+	var live []int
+	for i, t := range m.toasts {
+		if !t.dismissed {
+			live = append(live, i)
+		}
+	}
+	for n, i := range live {
+		m.toasts[i].target = m.canvasHeight() - (len(live)-n)*toastHeight
+	}
+}
+
+func (m *model) animate() tea.Cmd {
+	if m.animating {
+		return nil
+	}
+	m.animating = true
+	return tea.Tick(m.interval, func(time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "n":
+			m.nextID++
+			// New toasts slide in from below the canvas.
+			m.toasts = append(m.toasts, toast{
+				id:   m.nextID,
+				text: fmt.Sprintf("Notification #%d", m.nextID),
+				y:    m.canvasHeight(),
+			})
+			m.layout()
+			return m, m.animate()
+		default:
+			if len(msg.Runes) == 1 && msg.Runes[0] >= '1' && msg.Runes[0] <= '9' {
+				return m, m.dismiss(int(msg.Runes[0] - '1'))
+			}
+		}
+	case tickMsg:
+		m.animating = false
+		toasts := m.toasts[:0]
+		moving := false
+		for _, t := range m.toasts {
+			switch {
+			case t.y < t.target:
+				t.y++
+			case t.y > t.target:
+				t.y--
+			}
+			if t.dismissed && t.y == t.target {
+				// It has finished sliding away.
+				continue
+			}
+			moving = moving || t.y != t.target
+			toasts = append(toasts, t)
+		}
+		m.toasts = toasts
+		if moving {
+			return m, m.animate()
+		}
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.layout()
+		// Jump straight to the new layout rather than animate a resize.
+		for i := range m.toasts {
+			m.toasts[i].y = m.toasts[i].target
+		}
+	}
+	return m, nil
+}
+
+// dismiss dismisses the nth toast still showing, counting from the top.
+func (m *model) dismiss(n int) tea.Cmd {
+	for i := range m.toasts {
+		if m.toasts[i].dismissed {
+			continue
+		}
+		if n > 0 {
+			n--
+			continue
+		}
+		// Slide upwards by its height, behind the toast above, if any,
+		// which moves down to fill the gap.
+		m.toasts[i].dismissed = true
+		m.toasts[i].target = m.toasts[i].y - toastHeight
+		m.layout()
+		return m.animate()
+	}
+	return nil
+}
+
+func (m model) View() string {
+	rows := make([]string, m.canvasHeight())
+	pad := strings.Repeat(" ", max(0, m.width-toastWidth))
+	// Draw the dismissed toasts first, so the others are drawn on top.
+	for _, dismissed := range []bool{true, false} {
+		for _, t := range m.toasts {
+			if t.dismissed != dismissed {
+				continue
+			}
+			for j, line := range strings.Split(toastStyle.Render(t.text), "\n") {
+				if row := t.y + j; row >= 0 && row < len(rows) {
+					rows[row] = pad + line
+				}
+			}
+		}
+	}
+	return strings.Join(rows, "\n") + "\n" + statusStyle.Render("n: new toast • 1-9: dismiss • q: quit")
+}
+
+func main() {
+	p := tea.NewProgram(newModel(50*time.Millisecond), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+// settle sends enough ticks for any animation to finish. The tests make the
+// model's own ticks too slow to ever fire, so that the toasts move only when
+// the test says so, and messages are handled in the order they're sent.
+func settle(tm *teatest.TestModel) {
+	for range 20 {
+		tm.Send(tickMsg{})
+	}
+}
+
+// rowOf returns the row of the view on which the text appears, or -1.
+func rowOf(view, text string) int {
+	for i, line := range strings.Split(view, "\n") {
+		if strings.Contains(line, text) {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestDismissLower(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(time.Hour), teatest.WithInitialTermSize(60, 13))
+
+	tm.Type("nn")
+	settle(tm)
+
+	waitForString(t, tm, "Notification #2")
+
+	// Dismiss the lower toast once both have settled.
+	tm.Type("2")
+	settle(tm)
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	if len(fm.toasts) != 1 {
+		t.Fatalf("expected one toast, got %d", len(fm.toasts))
+	}
+	// The canvas is 12 rows, so the remaining toast's text, between its
+	// borders, should have moved down from row 7 to the bottom toast's
+	// row, 10.
+	view := fm.View()
+	if got := rowOf(view, "Notification #1"); got != 10 {
+		t.Errorf("expected upper toast to reflow down to row 10, got %d:\n%s", got, view)
+	}
+	if got := rowOf(view, "Notification #2"); got != -1 {
+		t.Errorf("expected lower toast to be gone, got row %d", got)
+	}
+}
+
+func TestDismissTop(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(time.Hour), teatest.WithInitialTermSize(60, 13))
+
+	tm.Type("nn")
+	settle(tm)
+
+	waitForString(t, tm, "Notification #2")
+
+	tm.Type("1")
+	// A new toast arrives while the top one is sliding away.
+	tm.Type("n")
+	settle(tm)
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	view := fm.View()
+	// The bottom toast moves up to make room for the new one, but no
+	// further: the gap left at the top doesn't need filling.
+	if got := rowOf(view, "Notification #2"); got != 7 {
+		t.Errorf("expected toast #2 on row 7, got %d:\n%s", got, view)
+	}
+	if got := rowOf(view, "Notification #3"); got != 10 {
+		t.Errorf("expected toast #3 on row 10, got %d:\n%s", got, view)
+	}
+	if got := rowOf(view, "Notification #1"); got != -1 {
+		t.Errorf("expected toast #1 to be gone, got row %d", got)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// steps is the number of ticks expanding or collapsing a node takes,
+// however many children it has.
+const steps = 4
+
+var (
+	cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	guideStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+)
+
+type node struct {
+	name     string
+	parent   *node
+	children []*node
+	expanded bool
+	// revealed is the number of rows beneath the node currently shown,
+	// which moves towards all of them when expanded, and none when
+	// collapsed, a step each tick.
+	revealed int
+}
+
+func (n *node) isDir() bool {
+	return n.children != nil
+}
+
+// row is a visible node, along with the guides to render before it.
+type row struct {
+	node   *node
+	prefix string
+}
+
+type tickMsg struct{}
+
+type model struct {
+	root     *node
+	rows     []row
+	selected *node
+	interval time.Duration
+	// animating is true while a tick is scheduled.
+	animating bool
+}
+
+func newModel(root *node, interval time.Duration) model {
+	m := model{root: root, selected: root, interval: interval}
+	setParents(root)
+	// Show whatever starts expanded straight away.
+	for m.flatten(true) {
+	}
+	return m
+}
+
+func setParents(n *node) {
+	for _, child := range n.children {
+		child.parent = n
+		setParents(child)
+	}
+}
+
+// flatten rebuilds the visible rows. If advance is true, each node that is
+// expanding or collapsing moves a step further, and it reports whether any
+// still have further to go.
+func (m *model) flatten(advance bool) bool {
+	rows, moving := m.walk(m.root, "", advance)
+	m.rows = append([]row{{node: m.root}}, rows...)
+	return moving
+}
+
+// walk returns the rows revealed beneath n.
+func (m *model) walk(n *node, indent string, advance bool) ([]row, bool) {
+	var (
+		rows   []row
+		moving bool
+	)
+	for i, child := range n.children {
+		// The last child closes its branch, so nothing is drawn beneath it
+		// at this depth.
+		guide, next := "├── ", "│   "
+		if i == len(n.children)-1 {
+			guide, next = "└── ", "    "
+		}
+		rows = append(rows, row{node: child, prefix: indent + guide})
+		childRows, childMoving := m.walk(child, indent+next, advance)
+		rows = append(rows, childRows...)
+		moving = moving || childMoving
+	}
+	target := 0
+	if n.expanded {
+		target = len(rows)
+	}
+	// Nested nodes collapsing can leave fewer rows than were revealed.
+	n.revealed = min(n.revealed, len(rows))
+	if advance && n.revealed != target {
+		// Move faster through more rows, so that it takes the same
+		// number of steps however many there are.
+		step := max(1, (len(rows)+steps-1)/steps)
+		if n.revealed < target {
+			n.revealed = min(target, n.revealed+step)
+		} else {
+			n.revealed = max(target, n.revealed-step)
+		}
+	}
+	moving = moving || n.revealed != target
+	return rows[:n.revealed], moving
+}
+
+func (m *model) animate() tea.Cmd {
+	if m.animating {
+		return nil
+	}
+	m.animating = true
+	return tea.Tick(m.interval, func(time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
+
+// cursor returns the index of the row of the selected node.
+func (m model) cursor() int {
+	for i, r := range m.rows {
+		if r.node == m.selected {
+			return i
+		}
+	}
+	return 0
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		cursor := m.cursor()
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.selected = m.rows[max(0, cursor-1)].node
+		case "down", "j":
+			m.selected = m.rows[min(len(m.rows)-1, cursor+1)].node
+		case "enter", " ":
+			if m.selected.isDir() {
+				return m, m.toggle(m.selected)
+			}
+		case "left", "h":
+			if m.selected.isDir() && m.selected.expanded {
+				return m, m.toggle(m.selected)
+			} else if m.selected.parent != nil {
+				m.selected = m.selected.parent
+			}
+		case "right", "l":
+			if m.selected.isDir() && !m.selected.expanded {
+				return m, m.toggle(m.selected)
+			}
+		}
+	case tickMsg:
+		m.animating = false
+		moving := m.flatten(true)
+		m.reselect()
+		if moving {
+			return m, m.animate()
+		}
+	}
+	return m, nil
+}
+
+// toggle starts expanding or collapsing the node. Other nodes that are
+// still expanding or collapsing carry on at the same time.
+func (m *model) toggle(n *node) tea.Cmd {
+	n.expanded = !n.expanded
+	return m.animate()
+}
+
+// reselect moves the selection to the nearest visible ancestor, should the
+// selected node have been hidden by an ancestor collapsing.
+func (m *model) reselect() {
+	for n := m.selected; n != nil; n = n.parent {
+		for _, r := range m.rows {
+			if r.node == n {
+				m.selected = n
+				return
+			}
+		}
+	}
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	for _, r := range m.rows {
+		name := r.node.name
+		if r.node.isDir() {
+			name += "/"
+		}
+		cursor := "  "
+		if r.node == m.selected {
+			cursor = "> "
+			name = cursorStyle.Render(name)
+		}
+		b.WriteString(cursor + guideStyle.Render(r.prefix) + name + "\n")
+	}
+	return b.String()
+}
+
+func dir(name string, children ...*node) *node {
+	if children == nil {
+		children = []*node{}
+	}
+	return &node{name: name, children: children}
+}
+
+func file(name string) *node {
+	return &node{name: name}
+}
+
+func main() {
+	var modules []*node
+	for i := 1; i <= 30; i++ {
+		modules = append(modules, file(fmt.Sprintf("module%02d", i)))
+	}
+	root := dir("project",
+		dir("cmd", dir("server", file("main.go"))),
+		dir("internal",
+			dir("api", file("handlers.go"), file("routes.go")),
+			dir("store", file("store.go"), file("migrations.go")),
+		),
+		dir("vendor", modules...),
+		file("go.mod"),
+		file("README.md"),
+	)
+	root.expanded = true
+	p := tea.NewProgram(newModel(root, 40*time.Millisecond))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func testTree() *node {
+	var many []*node
+	for i := 1; i <= 40; i++ {
+		many = append(many, file(fmt.Sprintf("pkg%02d", i)))
+	}
+	root := dir("project",
+		dir("src", file("a.go"), file("b.go"), file("c.go"), file("d.go")),
+		dir("docs", file("intro.md"), file("guide.md")),
+		dir("vendor", many...),
+	)
+	root.expanded = true
+	return root
+}
+
+func TestExpandProgressively(t *testing.T) {
+	// Tick only when the test says so.
+	tm := teatest.NewTestModel(t, newModel(testTree(), time.Hour), teatest.WithInitialTermSize(80, 60))
+
+	waitForFrame(t, tm, "vendor/")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// A row is revealed each tick, with its guide already drawn as it
+	// will be once the node is fully expanded.
+	frames := []struct {
+		want    string
+		notWant []string
+	}{
+		{"│   ├── a.go", []string{"b.go", "c.go", "d.go"}},
+		{"│   ├── b.go", []string{"c.go", "d.go"}},
+		{"│   ├── c.go", []string{"d.go"}},
+		{"│   └── d.go", nil},
+	}
+	for _, f := range frames {
+		tm.Send(tickMsg{})
+		waitForFrame(t, tm, f.want, f.notWant...)
+	}
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if got := fm.View(); !strings.Contains(got, "│   └── d.go") {
+		t.Errorf("expected src to be fully expanded:\n%s", got)
+	}
+}
+
+func TestConcurrentAndMany(t *testing.T) {
+	root := testTree()
+	src, docs, vendor := root.children[0], root.children[1], root.children[2]
+	m := newModel(root, time.Hour)
+
+	var tm tea.Model = m
+	send := func(msgs ...tea.Msg) {
+		for _, msg := range msgs {
+			tm, _ = tm.Update(msg)
+		}
+	}
+
+	// Expand src, vendor, and then docs while src is still expanding.
+	send(tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyEnter})
+	send(tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyEnter})
+	send(tickMsg{})
+	send(tea.KeyMsg{Type: tea.KeyUp}, tea.KeyMsg{Type: tea.KeyEnter})
+	send(tickMsg{})
+
+	if src.revealed != 2 || docs.revealed != 1 || vendor.revealed != 20 {
+		t.Errorf("unexpected revealed rows: src %d, docs %d, vendor %d", src.revealed, docs.revealed, vendor.revealed)
+	}
+
+	// Vendor has ten times as many children as src, but takes the same
+	// number of ticks.
+	send(tickMsg{}, tickMsg{})
+	if src.revealed != 4 || docs.revealed != 2 || vendor.revealed != 40 {
+		t.Errorf("unexpected revealed rows: src %d, docs %d, vendor %d", src.revealed, docs.revealed, vendor.revealed)
+	}
+
+	// Collapsing retraces the same steps.
+	send(tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyDown})
+	if sel := tm.(model).selected; sel != vendor {
+		t.Fatalf("expected vendor to be selected, got %s", sel.name)
+	}
+	send(tea.KeyMsg{Type: tea.KeyEnter}, tickMsg{})
+	if vendor.revealed != 30 {
+		t.Errorf("expected 30 rows beneath vendor, got %d", vendor.revealed)
+	}
+	send(tickMsg{}, tickMsg{}, tickMsg{})
+	if vendor.revealed != 0 {
+		t.Errorf("expected vendor to be collapsed, got %d rows", vendor.revealed)
+	}
+	if got := len(tm.(model).rows); got != 10 {
+		t.Errorf("expected 10 rows, got %d", got)
+	}
+}
+
+// waitForFrame waits for output containing want, failing if it contains
+// any of notWant.
+func waitForFrame(t *testing.T, tm *teatest.TestModel, want string, notWant ...string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			if !strings.Contains(string(b), want) {
+				return false
+			}
+			for _, s := range notWant {
+				if strings.Contains(string(b), s) {
+					t.Fatalf("expected %q not to be shown yet, got:\n%s", s, b)
+				}
+			}
+			return true
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
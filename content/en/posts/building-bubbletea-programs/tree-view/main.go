@@ -0,0 +1,163 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
+)
+
+var (
+	cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	guideStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+)
+
+type node struct {
+	name     string
+	children []*node
+	expanded bool
+}
+
+func (n *node) isDir() bool {
+	return n.children != nil
+}
+
+// row is a visible node, along with the guides to render before it.
+type row struct {
+	node   *node
+	parent int
+	prefix string
+}
+
+type model struct {
+	root   *node
+	rows   []row
+	cursor int
+	width  int
+}
+
+func newModel(root *node) model {
+	m := model{root: root}
+	m.flatten()
+	return m
+}
+
+// flatten rebuilds the list of visible rows, skipping the children of
+// collapsed nodes. The cursor moves through these rows only.
+func (m *model) flatten() {
+	m.rows = []row{{node: m.root, parent: -1}}
+	if m.root.expanded {
+		m.walk(m.root, 0, "")
+	}
+	m.cursor = min(m.cursor, len(m.rows)-1)
+}
+
+func (m *model) walk(n *node, parent int, indent string) {
+	for i, child := range n.children {
+		// The last child closes its branch, so nothing is drawn beneath it
+		// at this depth.
+		last := i == len(n.children)-1
+		guide, next := "├── ", "│   "
+		if last {
+			guide, next = "└── ", "    "
+		}
+		m.rows = append(m.rows, row{node: child, parent: parent, prefix: indent + guide})
+		if child.expanded {
+			m.walk(child, len(m.rows)-1, indent+next)
+		}
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		current := m.rows[m.cursor]
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = min(len(m.rows)-1, m.cursor+1)
+		case "enter", " ":
+			if current.node.isDir() {
+				current.node.expanded = !current.node.expanded
+				m.flatten()
+			}
+		case "right", "l":
+			if current.node.isDir() && !current.node.expanded {
+				current.node.expanded = true
+				m.flatten()
+			}
+		case "left", "h":
+			if current.node.isDir() && current.node.expanded {
+				current.node.expanded = false
+				m.flatten()
+			} else if current.parent >= 0 {
+				m.cursor = current.parent
+			}
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	for i, r := range m.rows {
+		name := r.node.name
+		if r.node.isDir() {
+			name += "/"
+		}
+		cursor := "  "
+		if i == m.cursor {
+			cursor = "> "
+			name = cursorStyle.Render(name)
+		}
+		line := cursor + guideStyle.Render(r.prefix) + name
+		if m.width > 0 {
+			// Deeply nested nodes can be wider than the terminal.
+			line = truncate.StringWithTail(line, uint(m.width), "…")
+		}
+		b.WriteString(line + "\n")
+	}
+	return b.String()
+}
+
+func dir(name string, children ...*node) *node {
+	if children == nil {
+		children = []*node{}
+	}
+	return &node{name: name, children: children}
+}
+
+func file(name string) *node {
+	return &node{name: name}
+}
+
+func main() {
+	root := dir("project",
+		dir("cmd", dir("server", file("main.go"))),
+		dir("internal",
+			dir("api", file("handlers.go"), file("routes.go")),
+			dir("store", dir("migrations", file("0001_init.sql"), file("0002_add_users_and_permissions_tables.sql"))),
+		),
+		dir("docs"),
+		file("go.mod"),
+		file("README.md"),
+	)
+	root.expanded = true
+	p := tea.NewProgram(newModel(root))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func newTree() *node {
+	root := dir("project",
+		dir("cmd", file("main.go"), file("flags.go")),
+		dir("internal", dir("a", dir("b", dir("c", file("a_very_long_file_name_indeed.go"))))),
+		file("go.mod"),
+	)
+	root.expanded = true
+	return root
+}
+
+func TestExpandCollapse(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(newTree()), teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "└── go.mod")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "│   ├── main.go\r\n  │   └── flags.go\r\n  ├── internal/")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	if len(fm.rows) != 4 {
+		t.Errorf("expected 4 visible rows after collapsing, got %d", len(fm.rows))
+	}
+}
+
+func TestDeeplyNested(t *testing.T) {
+	const width = 30
+	tm := teatest.NewTestModel(t, newModel(newTree()), teatest.WithInitialTermSize(width, 24))
+
+	// Expand internal/a/b/c, moving down onto each child in turn.
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	for i := 0; i < 4; i++ {
+		tm.Send(tea.KeyMsg{Type: tea.KeyRight})
+		tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	}
+
+	waitForString(t, tm, "└── a_very")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	for _, line := range strings.Split(strings.TrimSuffix(fm.View(), "\n"), "\n") {
+		if w := lipgloss.Width(line); w > width {
+			t.Errorf("expected line to fit in %d columns, got %d: %q", width, w, line)
+		}
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// warnThreshold is the remaining time under which a badge turns red.
+const warnThreshold = 10 * time.Second
+
+var (
+	nameStyle   = lipgloss.NewStyle().Width(12)
+	badgeStyle  = lipgloss.NewStyle().Padding(0, 1).Foreground(lipgloss.Color("0")).Background(lipgloss.Color("10"))
+	expireStyle = badgeStyle.Background(lipgloss.Color("9"))
+)
+
+type item struct {
+	name    string
+	expires time.Time
+}
+
+type tickMsg struct{}
+
+type model struct {
+	// now returns the current time. Tests replace it with a fake clock.
+	now   func() time.Time
+	items []item
+}
+
+func newModel(now func() time.Time, items []item) model {
+	m := model{now: now, items: items}
+	// Drop any items that have already expired rather than show them for
+	// a second.
+	m.prune()
+	return m
+}
+
+func (m model) Init() tea.Cmd {
+	return tick()
+}
+
+func tick() tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
+
+// prune removes every item that has expired, however many expire at once.
+func (m *model) prune() {
+	now := m.now()
+	items := m.items[:0]
+	for _, it := range m.items {
+		if it.expires.After(now) {
+			items = append(items, it)
+		}
+	}
+	m.items = items
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	case tickMsg:
+		m.prune()
+		return m, tick()
+	}
+	return m, nil
+}
+
+func (m model) View() string {
+	if len(m.items) == 0 {
+		return "all items have expired • q: quit\n"
+	}
+	now := m.now()
+	var b strings.Builder
+	for _, it := range m.items {
+		remaining := it.expires.Sub(now)
+		style := badgeStyle
+		if remaining < warnThreshold {
+			style = expireStyle
+		}
+		// Round up, so the badge never reads 0s while the item is still
+		// listed.
+		secs := int(math.Ceil(remaining.Seconds()))
+		b.WriteString(nameStyle.Render(it.name) + style.Render(fmt.Sprintf("%ds", secs)) + "\n")
+	}
+	b.WriteString("\nq: quit\n")
+	return b.String()
+}
+
+func main() {
+	now := time.Now()
+	items := []item{
+		{name: "session", expires: now.Add(15 * time.Second)},
+		{name: "token", expires: now.Add(8 * time.Second)},
+		{name: "cache", expires: now.Add(25 * time.Second)},
+		{name: "lock", expires: now.Add(8 * time.Second)},
+		{name: "stale", expires: now.Add(-time.Second)},
+	}
+	p := tea.NewProgram(newModel(time.Now, items))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+// fakeClock is a clock that only moves when told to.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestExpiry(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 8, 25, 12, 0, 0, 0, time.UTC)}
+	items := []item{
+		{name: "alpha", expires: clock.Now().Add(12 * time.Second)},
+		{name: "bravo", expires: clock.Now().Add(30 * time.Second)},
+		{name: "charlie", expires: clock.Now().Add(12 * time.Second)},
+		{name: "expired", expires: clock.Now().Add(-time.Second)},
+	}
+	m := newModel(clock.Now, items)
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, "alpha        12s")
+
+	clock.Advance(3 * time.Second)
+	tm.Send(tickMsg{})
+
+	waitForString(t, tm, "alpha        9s")
+
+	// Both alpha and charlie expire on the same tick.
+	clock.Advance(9 * time.Second)
+	tm.Send(tickMsg{})
+
+	waitForString(t, tm, "bravo        18s")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	if len(fm.items) != 1 || fm.items[0].name != "bravo" {
+		t.Errorf("expected only bravo to remain, got %v", fm.items)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	warningStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Bold(true)
+	hintStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	buttonStyle   = lipgloss.NewStyle().Padding(0, 2).Foreground(lipgloss.Color("15")).Background(lipgloss.Color("9"))
+	disabledStyle = lipgloss.NewStyle().Padding(0, 2).Foreground(lipgloss.Color("240")).Background(lipgloss.Color("236"))
+)
+
+type deletedMsg struct{ err error }
+
+type model struct {
+	resource string
+	delete   func(name string) error
+	input    textinput.Model
+	deleting bool
+	deleted  bool
+	err      error
+}
+
+func newModel(resource string, delete func(string) error) model {
+	input := textinput.New()
+	input.Prompt = "> "
+	input.Focus()
+	return model{resource: resource, delete: delete, input: input}
+}
+
+func (m model) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// matches reports whether the user has typed the resource's name exactly:
+// no trimming, and no case folding. Deleting the wrong thing is worse than
+// having to retype a trailing space.
+func (m model) matches() bool {
+	return m.input.Value() == m.resource
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "esc":
+			return m, tea.Quit
+		case "enter":
+			if !m.matches() || m.deleting {
+				return m, nil
+			}
+			m.deleting = true
+			name, del := m.resource, m.delete
+			return m, func() tea.Msg {
+				return deletedMsg{err: del(name)}
+			}
+		}
+	case deletedMsg:
+		m.deleting = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.deleted = true
+		return m, tea.Quit
+	}
+	if m.deleting {
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+func (m model) View() string {
+	if m.deleted {
+		return "Deleted " + m.resource + ".\n"
+	}
+	s := warningStyle.Render("This will permanently delete "+m.resource+".") + "\n\n" +
+		"Type " + lipgloss.NewStyle().Bold(true).Render(m.resource) + " to confirm:\n\n" +
+		m.input.View() + "\n\n"
+
+	button := disabledStyle.Render("Delete")
+	switch {
+	case m.deleting:
+		button = buttonStyle.Render("Deleting...")
+	case m.matches():
+		button = buttonStyle.Render("Delete")
+	case m.input.Value() != "":
+		button += " " + hintStyle.Render("name does not match")
+	}
+	s += button + "\n\n"
+	if m.err != nil {
+		s += warningStyle.Render("error: "+m.err.Error()) + "\n\n"
+	}
+	return s + "enter: delete • esc: cancel\n"
+}
+
+func deleteResource(name string) error {
+	time.Sleep(time.Second)
+	return nil
+}
+
+func main() {
+	p := tea.NewProgram(newModel("acme/production-db", deleteResource))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestConfirm(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		deleted []string
+	)
+	del := func(name string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		deleted = append(deleted, name)
+		return nil
+	}
+	tm := teatest.NewTestModel(t, newModel("acme/prod", del), teatest.WithInitialTermSize(80, 24))
+
+	// Wrong case.
+	tm.Type("ACME/prod")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "name does not match")
+
+	// Trailing space.
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlU})
+	tm.Type("acme/prod ")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "> acme/prod  ")
+
+	mu.Lock()
+	if len(deleted) != 0 {
+		t.Errorf("expected nothing to be deleted yet, got %q", deleted)
+	}
+	mu.Unlock()
+
+	// Remove the trailing space.
+	tm.Send(tea.KeyMsg{Type: tea.KeyBackspace})
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "Deleted acme/prod.")
+
+	tm.WaitFinished(t, teatest.WithFinalTimeout(time.Second))
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(deleted) != 1 || deleted[0] != "acme/prod" {
+		t.Errorf("expected acme/prod to be deleted once, got %q", deleted)
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	cursorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+	readOnlyStyle = lipgloss.NewStyle().Background(lipgloss.Color("214")).Foreground(lipgloss.Color("0")).Padding(0, 1)
+	flashStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Bold(true)
+)
+
+// clearFlashMsg clears the flash with the given id, unless it has since been
+// replaced by another.
+type clearFlashMsg struct{ id int }
+
+type model struct {
+	items    []string
+	cursor   int
+	readOnly bool
+
+	input   textinput.Model
+	editing bool
+	// searching is true while the input is being used to enter a search.
+	searching bool
+
+	flash   string
+	flashID int
+}
+
+func newModel(items []string) model {
+	return model{items: items, input: textinput.New()}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case clearFlashMsg:
+		if msg.id == m.flashID {
+			m.flash = ""
+		}
+		return m, nil
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "ctrl+r":
+			m.readOnly = !m.readOnly
+			if m.readOnly && m.editing {
+				// Discard the edit rather than let it be committed once
+				// in read-only mode.
+				m.editing = false
+				m.input.Blur()
+			}
+			return m, nil
+		}
+		if m.editing || m.searching {
+			return m.updateInput(msg)
+		}
+		switch msg.String() {
+		case "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = max(0, min(len(m.items)-1, m.cursor+1))
+		case "/":
+			m.searching = true
+			m.input.Prompt = "/"
+			m.input.SetValue("")
+			return m, m.input.Focus()
+		case "e", "d", "shift+up", "K", "shift+down", "J":
+			if m.readOnly {
+				return m, m.setFlash("read only: " + msg.String() + " is disabled")
+			}
+			return m.mutate(msg.String())
+		}
+	}
+	return m, nil
+}
+
+// mutate handles the keys that change the items.
+func (m model) mutate(key string) (tea.Model, tea.Cmd) {
+	if len(m.items) == 0 {
+		return m, nil
+	}
+	switch key {
+	case "e":
+		m.editing = true
+		m.input.Prompt = "rename: "
+		m.input.SetValue(m.items[m.cursor])
+		return m, m.input.Focus()
+	case "d":
+		m.items = append(m.items[:m.cursor], m.items[m.cursor+1:]...)
+		m.cursor = max(0, min(m.cursor, len(m.items)-1))
+	case "shift+up", "K":
+		if m.cursor > 0 {
+			m.items[m.cursor-1], m.items[m.cursor] = m.items[m.cursor], m.items[m.cursor-1]
+			m.cursor--
+		}
+	case "shift+down", "J":
+		if m.cursor < len(m.items)-1 {
+			m.items[m.cursor+1], m.items[m.cursor] = m.items[m.cursor], m.items[m.cursor+1]
+			m.cursor++
+		}
+	}
+	return m, nil
+}
+
+func (m model) updateInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.editing, m.searching = false, false
+		m.input.Blur()
+		return m, nil
+	case "enter":
+		if m.editing {
+			m.items[m.cursor] = m.input.Value()
+		} else {
+			m.search(m.input.Value())
+		}
+		m.editing, m.searching = false, false
+		m.input.Blur()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+// search moves the cursor to the next item containing the query.
+func (m *model) search(query string) {
+	for i := 1; i <= len(m.items); i++ {
+		idx := (m.cursor + i) % len(m.items)
+		if strings.Contains(m.items[idx], query) {
+			m.cursor = idx
+			return
+		}
+	}
+}
+
+func (m *model) setFlash(s string) tea.Cmd {
+	m.flash = s
+	m.flashID++
+	id := m.flashID
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return clearFlashMsg{id: id}
+	})
+}
+
+func (m model) View() string {
+	var b strings.Builder
+	if m.readOnly {
+		b.WriteString(readOnlyStyle.Render("READ ONLY") + "\n\n")
+	}
+	for i, item := range m.items {
+		if i == m.cursor {
+			b.WriteString(cursorStyle.Render("> " + item))
+		} else {
+			b.WriteString("  " + item)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	if m.editing || m.searching {
+		b.WriteString(m.input.View() + "\n")
+	}
+	if m.flash != "" {
+		b.WriteString(flashStyle.Render(m.flash) + "\n")
+	}
+	b.WriteString("j/k: move • /: search • e: rename • d: delete • J/K: reorder • ctrl+r: read only • q: quit\n")
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel([]string{"alpha", "bravo", "charlie", "delta", "echo"}))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
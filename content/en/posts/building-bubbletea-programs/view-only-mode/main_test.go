@@ -0,0 +1,90 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestReadOnlyDelete(t *testing.T) {
+	m := newModel([]string{"alpha", "bravo", "charlie"})
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(100, 24))
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlR})
+
+	waitForString(t, tm, "READ ONLY")
+
+	tm.Type("d")
+
+	waitForString(t, tm, "read only: d is disabled")
+
+	// Navigation and search still work.
+	tm.Type("/char")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+
+	waitForString(t, tm, "> charlie")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if !slices.Equal(fm.items, []string{"alpha", "bravo", "charlie"}) {
+		t.Errorf("expected items to be unchanged, got %v", fm.items)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	m := newModel([]string{"alpha", "bravo", "charlie"})
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(100, 24))
+
+	tm.Type("d")
+
+	waitForString(t, tm, "> bravo")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if !slices.Equal(fm.items, []string{"bravo", "charlie"}) {
+		t.Errorf("expected alpha to be deleted, got %v", fm.items)
+	}
+}
+
+func TestReadOnlyCancelsEdit(t *testing.T) {
+	m := newModel([]string{"alpha", "bravo"})
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(100, 24))
+
+	tm.Type("e")
+	tm.Type("-renamed")
+
+	waitForString(t, tm, "alpha-renamed")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyCtrlR})
+
+	waitForString(t, tm, "READ ONLY")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+	tm.Type("q")
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.editing {
+		t.Error("expected edit to be cancelled")
+	}
+	if fm.items[0] != "alpha" {
+		t.Errorf("expected rename to be discarded, got %q", fm.items[0])
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var cursorStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+
+const footer = "\nj/k: move • q: quit\n"
+
+type model struct {
+	items  []string
+	cursor int
+}
+
+func newModel(n int) model {
+	items := make([]string, n)
+	for i := range items {
+		items[i] = fmt.Sprintf("item %05d", i+1)
+	}
+	return model{items: items}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(0, m.cursor-1)
+		case "down", "j":
+			m.cursor = min(len(m.items)-1, m.cursor+1)
+		}
+	}
+	return m, nil
+}
+
+// naiveView builds the view by repeated concatenation. Every += allocates a
+// new string and copies everything so far into it, so the cost grows with
+// the square of the number of lines.
+func (m model) naiveView() string {
+	var s string
+	for i, item := range m.items {
+		if i == m.cursor {
+			s += cursorStyle.Render("> "+item) + "\n"
+		} else {
+			s += "  " + item + "\n"
+		}
+	}
+	s += footer
+	return s
+}
+
+// builderView builds the view with a strings.Builder, which appends to a
+// buffer, only reallocating as it grows.
+func (m model) builderView() string {
+	var b strings.Builder
+	m.writeLines(&b)
+	return b.String()
+}
+
+// View is builderView, with the buffer grown up front to fit the whole view,
+// so it needn't reallocate at all. The size is an estimate: the cursor's
+// styling isn't accounted for, but if the estimate falls short the builder
+// just grows as usual.
+func (m model) View() string {
+	var b strings.Builder
+	size := len(footer)
+	for _, item := range m.items {
+		size += len("  ") + len(item) + len("\n")
+	}
+	b.Grow(size)
+	m.writeLines(&b)
+	return b.String()
+}
+
+func (m model) writeLines(b *strings.Builder) {
+	for i, item := range m.items {
+		if i == m.cursor {
+			b.WriteString(cursorStyle.Render("> " + item))
+		} else {
+			b.WriteString("  ")
+			b.WriteString(item)
+		}
+		b.WriteByte('\n')
+	}
+	b.WriteString(footer)
+}
+
+func main() {
+	p := tea.NewProgram(newModel(20))
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
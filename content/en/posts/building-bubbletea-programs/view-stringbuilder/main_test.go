@@ -0,0 +1,75 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestView(t *testing.T) {
+	tm := teatest.NewTestModel(t, newModel(20), teatest.WithInitialTermSize(80, 24))
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+	tm.Send(tea.KeyMsg{Type: tea.KeyDown})
+
+	waitForString(t, tm, "> item 00003")
+
+	tm.Type("q")
+
+	fm := tm.FinalModel(t).(model)
+	if got, want := fm.View(), fm.naiveView(); got != want {
+		t.Errorf("expected View to render the same as naiveView:\nwant:\n%s\ngot:\n%s", want, got)
+	}
+	if got, want := fm.builderView(), fm.naiveView(); got != want {
+		t.Errorf("expected builderView to render the same as naiveView:\nwant:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+// Run with:
+//
+//	go test -bench . -benchmem
+//
+// For 10,000 items, concatenation allocates hundreds of megabytes per
+// render, against hundreds of kilobytes for the builder:
+//
+//	BenchmarkNaiveView          139731295 ns/op  683347617 B/op  10993 allocs/op
+//	BenchmarkBuilderView           418020 ns/op     534977 B/op   1015 allocs/op
+//	BenchmarkBuilderViewGrow       213759 ns/op     151249 B/op    993 allocs/op
+func BenchmarkNaiveView(b *testing.B) {
+	m := newModel(10_000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = m.naiveView()
+	}
+}
+
+func BenchmarkBuilderView(b *testing.B) {
+	m := newModel(10_000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = m.builderView()
+	}
+}
+
+func BenchmarkBuilderViewGrow(b *testing.B) {
+	m := newModel(10_000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = m.View()
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}
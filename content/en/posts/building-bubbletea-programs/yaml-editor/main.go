@@ -0,0 +1,122 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	badgeStyle   = lipgloss.NewStyle().Padding(0, 1).Foreground(lipgloss.Color("0"))
+	validStyle   = badgeStyle.Background(lipgloss.Color("10"))
+	invalidStyle = badgeStyle.Background(lipgloss.Color("9"))
+	emptyStyle   = badgeStyle.Background(lipgloss.Color("8"))
+	errorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	lineStyle    = lipgloss.NewStyle().Background(lipgloss.Color("52"))
+)
+
+// lineRegex extracts the line number from a yaml parse error.
+var lineRegex = regexp.MustCompile(`line (\d+)`)
+
+type model struct {
+	textarea textarea.Model
+	err      error
+	// errLine is the 1-based line number of the error, or 0 if unknown.
+	errLine int
+}
+
+func newModel() model {
+	ta := textarea.New()
+	ta.Placeholder = "enter some yaml..."
+	ta.SetWidth(60)
+	ta.SetHeight(10)
+	ta.Focus()
+	return model{textarea: ta}
+}
+
+func (m model) Init() tea.Cmd {
+	return textarea.Blink
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "esc":
+			return m, tea.Quit
+		}
+	}
+
+	before := m.textarea.Value()
+	var cmd tea.Cmd
+	m.textarea, cmd = m.textarea.Update(msg)
+	if m.textarea.Value() != before {
+		m.errLine, m.err = validate(m.textarea.Value())
+	}
+	return m, cmd
+}
+
+// validate parses the yaml, returning any error along with the line it
+// occurred on.
+func validate(s string) (int, error) {
+	// The yaml parser's error for a tab is rather cryptic, so check for
+	// tabs first.
+	for i, line := range strings.Split(s, "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		if strings.HasPrefix(trimmed, "\t") {
+			return i + 1, fmt.Errorf("line %d: tabs are not allowed for indentation", i+1)
+		}
+	}
+	var v any
+	if err := yaml.Unmarshal([]byte(s), &v); err != nil {
+		line := 0
+		if m := lineRegex.FindStringSubmatch(err.Error()); m != nil {
+			line, _ = strconv.Atoi(m[1])
+		}
+		return line, errors.New(strings.TrimPrefix(err.Error(), "yaml: "))
+	}
+	return 0, nil
+}
+
+func (m model) View() string {
+	var badge string
+	switch {
+	case strings.TrimSpace(m.textarea.Value()) == "":
+		badge = emptyStyle.Render("empty")
+	case m.err != nil:
+		badge = invalidStyle.Render("invalid")
+	default:
+		badge = validStyle.Render("valid")
+	}
+
+	var b strings.Builder
+	b.WriteString(badge + "\n\n")
+	b.WriteString(m.textarea.View() + "\n\n")
+	if m.err != nil {
+		b.WriteString(errorStyle.Render(m.err.Error()) + "\n")
+		lines := strings.Split(m.textarea.Value(), "\n")
+		if m.errLine > 0 && m.errLine <= len(lines) {
+			// Make tabs visible by replacing them with an arrow.
+			line := strings.ReplaceAll(lines[m.errLine-1], "\t", "→")
+			b.WriteString(lineStyle.Render(fmt.Sprintf("%3d │ %s", m.errLine, line)) + "\n")
+		}
+	}
+	b.WriteString("\nesc: quit\n")
+	return b.String()
+}
+
+func main() {
+	p := tea.NewProgram(newModel())
+	if _, err := p.Run(); err != nil {
+		fmt.Println("could not start program:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+)
+
+func TestValidation(t *testing.T) {
+	m := newModel()
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(80, 24))
+
+	waitForString(t, tm, " empty ")
+
+	tm.Type("name: bubbletea")
+	tm.Send(tea.KeyMsg{Type: tea.KeyEnter})
+	tm.Type("tags: [tui, go")
+
+	waitForString(t, tm, " invalid ")
+
+	tm.Type("]")
+
+	waitForString(t, tm, " valid ")
+
+	tm.Send(tea.KeyMsg{Type: tea.KeyEsc})
+
+	fm := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	if fm.err != nil {
+		t.Errorf("expected no error, got %v", fm.err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		wantErr  bool
+		wantLine int
+	}{
+		{"empty", "", false, 0},
+		{"valid", "a: 1\nb:\n  c: 2", false, 0},
+		{"tab indentation", "a:\n\tb: 1", true, 2},
+		{"nested mapping", "a: 1\nb: c: 2", true, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line, err := validate(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if line != tt.wantLine {
+				t.Errorf("expected error on line %d, got %d", tt.wantLine, line)
+			}
+		})
+	}
+}
+
+func waitForString(t *testing.T, tm *teatest.TestModel, s string) {
+	teatest.WaitFor(
+		t,
+		tm.Output(),
+		func(b []byte) bool {
+			return strings.Contains(string(b), s)
+		},
+		teatest.WithCheckInterval(time.Millisecond*100),
+		teatest.WithDuration(time.Second*10),
+	)
+}